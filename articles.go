@@ -0,0 +1,178 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/shopspring/decimal"
+)
+
+// ArticleType is the type of an article.
+type ArticleType string
+
+const (
+	ArticleTypeProduct ArticleType = "PRODUCT"
+	ArticleTypeService ArticleType = "SERVICE"
+)
+
+// ArticleLeadingPrice defines which of the net or gross price is leading,
+// the other one is computed by lexoffice.
+type ArticleLeadingPrice string
+
+const (
+	ArticleLeadingPriceNet   ArticleLeadingPrice = "NET"
+	ArticleLeadingPriceGross ArticleLeadingPrice = "GROSS"
+)
+
+// Article is to define body data
+type Article struct {
+	ID             string       `json:"id,omitempty"`
+	OrganizationID string       `json:"organizationId,omitempty"`
+	CreatedDate    *Date        `json:"createdDate,omitempty"`
+	UpdatedDate    *Date        `json:"updatedDate,omitempty"`
+	Archived       bool         `json:"archived,omitempty"`
+	Title          string       `json:"title"`
+	Description    string       `json:"description,omitempty"`
+	Type           ArticleType  `json:"type"`
+	ArticleNumber  string       `json:"articleNumber,omitempty"`
+	GTIN           string       `json:"gtin,omitempty"`
+	Note           string       `json:"note,omitempty"`
+	UnitName       string       `json:"unitName"`
+	Price          ArticlePrice `json:"price"`
+	Version        int          `json:"version"`
+}
+
+// ArticlePrice is the price of an article.
+// Only the leading price needs to be set, lexoffice computes the other one.
+type ArticlePrice struct {
+	NetPrice     *decimal.Decimal    `json:"netPrice,omitempty"`
+	GrossPrice   *decimal.Decimal    `json:"grossPrice,omitempty"`
+	LeadingPrice ArticleLeadingPrice `json:"leadingPrice"`
+	TaxRate      decimal.Decimal     `json:"taxRate"`
+}
+
+// ArticlesReturn is to decode json data
+type ArticlesReturn struct {
+	Content          []Article            `json:"content"`
+	First            bool                 `json:"first"`
+	Last             bool                 `json:"last"`
+	TotalPages       int                  `json:"totalPages"`
+	TotalElements    int                  `json:"totalElements"`
+	NumberOfElements int                  `json:"numberOfElements"`
+	Size             int                  `json:"size"`
+	Number           int                  `json:"number"`
+	Sort             []ContactsReturnSort `json:"sort"`
+}
+
+type GetArticlesParams struct {
+	Page          omit.Val[int]
+	Size          omit.Val[int]
+	ArticleNumber omit.Val[string]
+	GTIN          omit.Val[string]
+	Type          omit.Val[ArticleType]
+}
+
+// GetArticles is to get a list of all articles
+// <https://developers.lexoffice.io/docs/?shell#articles-endpoint-filtering-articles>
+func (c *Client) GetArticles(ctx context.Context, p GetArticlesParams) (ArticlesReturn, error) {
+	var ar ArticlesReturn
+
+	qb := c.Request("/v1/articles").
+		ToJSON(&ar)
+
+	if p.Page.IsSet() {
+		qb.ParamInt("page", p.Page.MustGet())
+	}
+
+	if p.Size.IsSet() {
+		qb.ParamInt("size", p.Size.MustGet())
+	}
+
+	if p.ArticleNumber.IsSet() {
+		qb.Param("articleNumber", p.ArticleNumber.MustGet())
+	}
+
+	if p.GTIN.IsSet() {
+		qb.Param("gtin", p.GTIN.MustGet())
+	}
+
+	if p.Type.IsSet() {
+		qb.Param("type", string(p.Type.MustGet()))
+	}
+
+	err := qb.Fetch(ctx)
+	if err != nil {
+		return ArticlesReturn{}, fmt.Errorf("error getting articles: %w", err)
+	}
+
+	return ar, nil
+}
+
+// ArticlesPager returns a pager over all articles matching p, starting at p.Page
+func (c *Client) ArticlesPager(p GetArticlesParams, o PagerOptions) *Pager[Article] {
+	if o.Size > 0 {
+		p.Size = omit.From(o.Size)
+	}
+
+	return NewPager(func(ctx context.Context, page int) ([]Article, bool, error) {
+		p.Page = omit.From(page)
+		ar, err := c.GetArticles(ctx, p)
+		return ar.Content, ar.Last, err
+	}, p.Page.GetOrZero(), o.MaxPages)
+}
+
+// GetArticle is to get an article by id
+// <https://developers.lexoffice.io/docs/?shell#articles-endpoint-retrieve-an-article>
+func (c *Client) GetArticle(ctx context.Context, id string) (Article, error) {
+	var a Article
+	err := c.Requestf("/v1/articles/%s", id).ToJSON(&a).Fetch(ctx)
+	if err != nil {
+		return a, fmt.Errorf("error getting article: %w", err)
+	}
+
+	return a, nil
+}
+
+// CreateArticle creates a new article
+// <https://developers.lexoffice.io/docs/?shell#articles-endpoint-create-an-article>
+func (c *Client) CreateArticle(ctx context.Context, body Article) (InvoiceResponse, error) {
+	var ir InvoiceResponse
+	err := c.Request("/v1/articles").
+		BodyJSON(body).
+		ToJSON(&ir).
+		Post().
+		Fetch(ctx)
+	if err != nil {
+		return ir, fmt.Errorf("error creating article: %w", err)
+	}
+
+	return ir, nil
+}
+
+// UpdateArticle updates an existing article
+// <https://developers.lexoffice.io/docs/?shell#articles-endpoint-update-an-article>
+func (c *Client) UpdateArticle(ctx context.Context, body Article) (InvoiceResponse, error) {
+	var ir InvoiceResponse
+	err := c.Requestf("/v1/articles/%s", body.ID).
+		BodyJSON(body).
+		ToJSON(&ir).
+		Put().
+		Fetch(ctx)
+	if err != nil {
+		return ir, fmt.Errorf("error updating article: %w", err)
+	}
+
+	return ir, nil
+}
+
+// DeleteArticle deletes an article
+// <https://developers.lexoffice.io/docs/?shell#articles-endpoint-delete-an-article>
+func (c *Client) DeleteArticle(ctx context.Context, id string) error {
+	err := c.Requestf("/v1/articles/%s", id).Delete().Fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting article: %w", err)
+	}
+
+	return nil
+}
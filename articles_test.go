@@ -0,0 +1,124 @@
+package golexoffice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArticles(t *testing.T) {
+	server := articleMock(t)
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("create", func(t *testing.T) {
+		net := decimal.RequireFromString("61.9")
+		resp, err := config.CreateArticle(context.Background(), lexoffice.Article{
+			Title:    "Lexware buchhaltung Premium 2024",
+			Type:     lexoffice.ArticleTypeProduct,
+			UnitName: "Download-Code",
+			Price: lexoffice.ArticlePrice{
+				NetPrice:     &net,
+				LeadingPrice: lexoffice.ArticleLeadingPriceNet,
+				TaxRate:      decimal.NewFromInt(19),
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "eb46d328-e1dd-11eb-8a2e-4b3b8bb4e0a2", resp.ID)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := config.GetArticles(context.Background(), lexoffice.GetArticlesParams{
+			ArticleNumber: omit.From("LXW-BUHA-2024-001"),
+			GTIN:          omit.From("9783648170632"),
+			Type:          omit.From(lexoffice.ArticleTypeProduct),
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, resp.Content, 1) {
+			price := resp.Content[0].Price
+			assert.Nil(t, price.NetPrice)
+			if assert.NotNil(t, price.GrossPrice) {
+				assert.True(t, decimal.RequireFromString("73.66").Equal(*price.GrossPrice))
+			}
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err := config.DeleteArticle(context.Background(), "eb46d328-e1dd-11eb-8a2e-4b3b8bb4e0a2")
+		assert.NoError(t, err)
+	})
+}
+
+func articleMock(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/articles" {
+			var body struct {
+				Price map[string]any `json:"price"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "61.9", body.Price["netPrice"])
+			assert.Equal(t, "NET", body.Price["leadingPrice"])
+			assert.NotContains(t, body.Price, "grossPrice")
+
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "eb46d328-e1dd-11eb-8a2e-4b3b8bb4e0a2",
+				"resourceUri": "https://api.lexoffice.io/v1/articles/eb46d328-e1dd-11eb-8a2e-4b3b8bb4e0a2",
+				"createdDate": "2021-07-10T13:26:46.755+02:00",
+				"updatedDate": "2021-07-10T13:26:46.755+02:00",
+				"version": 1
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/articles" {
+			q := r.URL.Query()
+			assert.Equal(t, "LXW-BUHA-2024-001", q.Get("articleNumber"))
+			assert.Equal(t, "9783648170632", q.Get("gtin"))
+			assert.Equal(t, "PRODUCT", q.Get("type"))
+			assert.False(t, q.Has("page"))
+
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"content": [{
+					"id": "eb46d328-e1dd-11eb-8a2e-4b3b8bb4e0a2",
+					"title": "Lexware buchhaltung Premium 2024",
+					"type": "PRODUCT",
+					"articleNumber": "LXW-BUHA-2024-001",
+					"gtin": "9783648170632",
+					"unitName": "Download-Code",
+					"price": {"grossPrice": 73.66, "leadingPrice": "GROSS", "taxRate": 19},
+					"version": 1
+				}],
+				"first": true,
+				"last": true,
+				"totalPages": 1,
+				"totalElements": 1,
+				"numberOfElements": 1,
+				"size": 25,
+				"number": 0
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodDelete && r.URL.Path == "/v1/articles/eb46d328-e1dd-11eb-8a2e-4b3b8bb4e0a2" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		//nolint:errcheck
+		w.Write([]byte(fmt.Sprintf(`{"message": "not found (%s): %s"}`, r.Method, r.RequestURI)))
+	}))
+}
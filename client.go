@@ -30,8 +30,15 @@ type Client struct {
 	httpClient *http.Client
 
 	rate *rate.Limiter
+
+	retry *RetryPolicy
+
+	// conflictRetries is how many times read-modify-write helpers retry on a version conflict
+	conflictRetries int
 }
 
+// WithClient sets the client whose transport, timeout, jar and redirect policy are used.
+// The given client itself is never modified.
 func WithClient(client *http.Client) func(*Client) {
 	return func(c *Client) {
 		c.httpClient = client
@@ -50,30 +57,81 @@ func WithRate(opPerSecond int) func(*Client) {
 	}
 }
 
+// WithRetry retries idempotent requests failing with 429, 502, 503 or 504,
+// see RetryPolicy. Fields left empty are taken from DefaultRetryPolicy.
+func WithRetry(policy RetryPolicy) func(*Client) {
+	return func(c *Client) {
+		if policy.MaxAttempts == 0 {
+			policy.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+		}
+		if policy.MaxElapsed == 0 {
+			policy.MaxElapsed = DefaultRetryPolicy.MaxElapsed
+		}
+		if policy.BaseDelay == 0 {
+			policy.BaseDelay = DefaultRetryPolicy.BaseDelay
+		}
+		if policy.MaxDelay == 0 {
+			policy.MaxDelay = DefaultRetryPolicy.MaxDelay
+		}
+		c.retry = &policy
+	}
+}
+
+// WithConflictRetries sets how many times helpers such as ModifyContact
+// fetch again and retry after a version conflict. It defaults to 3.
+func WithConflictRetries(n int) func(*Client) {
+	return func(c *Client) {
+		c.conflictRetries = n
+	}
+}
+
 func NewClient(token string, o ...func(*Client)) *Client {
 	client := &Client{
-		httpClient: http.DefaultClient,
-		baseUrl:    baseURL,
+		httpClient:      http.DefaultClient,
+		baseUrl:         baseURL,
+		conflictRetries: 3,
 	}
 
 	for _, option := range o {
 		option(client)
 	}
 
-	// set client for auth
-	client.httpClient.Transport = &oauth2.Transport{
+	// build our own client, so the caller's client (or http.DefaultClient)
+	// never gets the token or the other transports
+	transport := client.httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	// set transport for auth
+	transport = &oauth2.Transport{
 		Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}),
-		Base:   client.httpClient.Transport,
+		Base:   transport,
 	}
 
 	// if rate is set, wrap transport with a rate limiter
 	if client.rate != nil {
-		client.httpClient.Transport = rateTransport{
+		transport = rateTransport{
 			limiter: client.rate,
-			base:    client.httpClient.Transport,
+			base:    transport,
 		}
 	}
 
+	// if retry is set, wrap transport so every attempt goes through the rate limiter
+	if client.retry != nil {
+		transport = retryTransport{
+			policy: *client.retry,
+			base:   transport,
+		}
+	}
+
+	client.httpClient = &http.Client{
+		Transport:     transport,
+		CheckRedirect: client.httpClient.CheckRedirect,
+		Jar:           client.httpClient.Jar,
+		Timeout:       client.httpClient.Timeout,
+	}
+
 	return client
 }
 
@@ -82,6 +140,7 @@ func (c *Client) Request(path string) *requests.Builder {
 		URL(c.baseUrl).
 		Path(path).
 		Accept("application/json").
+		AddValidator(checkError).
 		Client(c.httpClient)
 }
 
@@ -90,6 +149,7 @@ func (c *Client) Requestf(path string, args ...any) *requests.Builder {
 		URL(c.baseUrl).
 		Pathf(path, args...).
 		Accept("application/json").
+		AddValidator(checkError).
 		Client(c.httpClient)
 }
 
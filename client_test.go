@@ -0,0 +1,72 @@
+package golexoffice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientTokenLeak(t *testing.T) {
+	// records the Authorization header of the last request
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		//nolint:errcheck
+		w.Write([]byte(`{"organizationId": "aa93e8a8-2aa3-470b-b914-caad8a255dd8"}`))
+	}))
+	defer server.Close()
+
+	t.Run("client=default", func(t *testing.T) {
+		transport := http.DefaultClient.Transport
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL), lexoffice.WithRate(100))
+		_, err := c.GetProfile(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer api-key", auth)
+
+		assert.Equal(t, transport, http.DefaultClient.Transport)
+
+		res, err := http.Get(server.URL)
+		assert.NoError(t, err)
+		res.Body.Close()
+		assert.Empty(t, auth)
+	})
+
+	t.Run("client=custom", func(t *testing.T) {
+		custom := &http.Client{}
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(custom), lexoffice.WithBaseUrl(server.URL), lexoffice.WithRetry(lexoffice.RetryPolicy{}))
+		_, err := c.GetProfile(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer api-key", auth)
+
+		assert.Nil(t, custom.Transport)
+
+		res, err := custom.Get(server.URL)
+		assert.NoError(t, err)
+		res.Body.Close()
+		assert.Empty(t, auth)
+	})
+
+	t.Run("tokens=two", func(t *testing.T) {
+		first := lexoffice.NewClient("first-key", lexoffice.WithBaseUrl(server.URL))
+		second := lexoffice.NewClient("second-key", lexoffice.WithBaseUrl(server.URL))
+
+		_, err := first.GetProfile(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer first-key", auth)
+
+		_, err = second.GetProfile(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer second-key", auth)
+
+		_, err = first.GetProfile(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer first-key", auth)
+	})
+}
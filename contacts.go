@@ -11,6 +11,7 @@ package golexoffice
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -41,6 +42,7 @@ type ContactsContent struct {
 	PhoneNumbers   ContactBodyPhoneNumbers   `json:"phoneNumbers"`
 	Note           string                    `json:"note"`
 	Archived       bool                      `json:"archived,omitempty"`
+	XRechnung      *XRechnung                `json:"xRechnung,omitempty"`
 }
 
 type ContactsReturnRoles struct {
@@ -117,6 +119,23 @@ type ContactsReturnSort struct {
 	Ascending    bool   `json:"ascending"`
 }
 
+// Body turns a retrieved contact into a ContactBody, to update it
+func (cc ContactsContent) Body() ContactBody {
+	return ContactBody{
+		Id:             cc.Id,
+		Version:        cc.Version,
+		Roles:          cc.Roles,
+		Company:        cc.Company,
+		Person:         cc.Person,
+		Addresses:      &cc.Addresses,
+		EmailAddresses: &cc.EmailAddresses,
+		PhoneNumbers:   &cc.PhoneNumbers,
+		Note:           cc.Note,
+		Archived:       cc.Archived,
+		XRechnung:      cc.XRechnung,
+	}
+}
+
 // ContactBody is to create a new contact
 type ContactBody struct {
 	Id             string                     `json:"id,omitempty"`
@@ -129,6 +148,7 @@ type ContactBody struct {
 	PhoneNumbers   *ContactBodyPhoneNumbers   `json:"phoneNumbers,omitempty"`
 	Note           string                     `json:"note"`
 	Archived       bool                       `json:"archived,omitempty"`
+	XRechnung      *XRechnung                 `json:"xRechnung,omitempty"`
 }
 
 type ContactBodyRoles struct {
@@ -213,6 +233,7 @@ type ContactsResponse struct {
 
 type GetContactsParams struct {
 	Page   omit.Val[int]
+	Size   omit.Val[int]
 	Email  omit.Val[string]
 	Name   omit.Val[string]
 	Number omit.Val[int]
@@ -233,26 +254,28 @@ type GetContactsParams struct {
 // GetContacts is to get a list of all contacts
 // <https://developers.lexoffice.io/docs/?shell#contacts-endpoint-filtering-contacts>
 func (c *Client) GetContacts(ctx context.Context, p GetContactsParams) (ContactsReturn, error) {
-	var er LegacyErrorResponse
 	var cr ContactsReturn
 
 	qb := c.Request("/v1/contacts").
-		ToJSON(&cr).
-		ErrorJSON(&er)
+		ToJSON(&cr)
 
 	if p.Page.IsSet() {
 		qb.ParamInt("page", p.Page.MustGet())
 	}
 
-	if p.Page.IsSet() {
+	if p.Size.IsSet() {
+		qb.ParamInt("size", p.Size.MustGet())
+	}
+
+	if p.Email.IsSet() {
 		qb.Param("email", p.Email.MustGet())
 	}
 
-	if p.Page.IsSet() {
+	if p.Name.IsSet() {
 		qb.Param("name", p.Name.MustGet())
 	}
 
-	if p.Page.IsSet() {
+	if p.Number.IsSet() {
 		qb.ParamInt("number", p.Number.MustGet())
 	}
 
@@ -266,21 +289,33 @@ func (c *Client) GetContacts(ctx context.Context, p GetContactsParams) (Contacts
 
 	err := qb.Fetch(ctx)
 	if err != nil {
-		return ContactsReturn{}, fmt.Errorf("error getting contacts (%s): %w", er.String(), err)
+		return ContactsReturn{}, fmt.Errorf("error getting contacts: %w", err)
 	}
 
 	return cr, nil
 
 }
 
+// ContactsPager returns a pager over all contacts matching p, starting at p.Page
+func (c *Client) ContactsPager(p GetContactsParams, o PagerOptions) *Pager[ContactsContent] {
+	if o.Size > 0 {
+		p.Size = omit.From(o.Size)
+	}
+
+	return NewPager(func(ctx context.Context, page int) ([]ContactsContent, bool, error) {
+		p.Page = omit.From(page)
+		cr, err := c.GetContacts(ctx, p)
+		return cr.Content, cr.Last, err
+	}, p.Page.GetOrZero(), o.MaxPages)
+}
+
 // GetContact is to get a contact by id
 // <https://developers.lexoffice.io/docs/?shell#contacts-endpoint-retrieve-a-contact>
 func (c *Client) GetContact(ctx context.Context, id string) (ContactsContent, error) {
-	var er LegacyErrorResponse
 	var crc ContactsContent
-	err := c.Requestf("/v1/contacts/%s", id).ToJSON(&crc).ErrorJSON(&er).Fetch(ctx)
+	err := c.Requestf("/v1/contacts/%s", id).ToJSON(&crc).Fetch(ctx)
 	if err != nil {
-		return crc, fmt.Errorf("error getting contact (%s): %w", er.String(), err)
+		return crc, fmt.Errorf("error getting contact: %w", err)
 	}
 	return crc, nil
 
@@ -289,16 +324,18 @@ func (c *Client) GetContact(ctx context.Context, id string) (ContactsContent, er
 // CreateContact creates a new contact
 // <https://developers.lexoffice.io/docs/?shell#contacts-endpoint-create-a-contact>
 func (c *Client) CreateContact(ctx context.Context, body ContactBody) (ContactsResponse, error) {
-	var er LegacyErrorResponse
+	if err := body.XRechnung.Validate(); err != nil {
+		return ContactsResponse{}, fmt.Errorf("error creating contacts: %w", err)
+	}
+
 	var cr ContactsResponse
 	err := c.Request("/v1/contacts").
 		BodyJSON(body).
 		ToJSON(&cr).
-		ErrorJSON(&er).
 		Post().
 		Fetch(ctx)
 	if err != nil {
-		return cr, fmt.Errorf("error creating contacts (%s): %w", er.String(), err)
+		return cr, fmt.Errorf("error creating contacts: %w", err)
 	}
 
 	return cr, nil
@@ -307,17 +344,44 @@ func (c *Client) CreateContact(ctx context.Context, body ContactBody) (ContactsR
 // UpdateContact updates existing contact
 // <https://developers.lexoffice.io/docs/?shell#contacts-endpoint-update-a-contact>
 func (c *Client) UpdateContact(ctx context.Context, body ContactBody) (ContactsResponse, error) {
-	var er LegacyErrorResponse
+	if err := body.XRechnung.Validate(); err != nil {
+		return ContactsResponse{}, fmt.Errorf("error updating contacts: %w", err)
+	}
+
 	var cr ContactsResponse
 	err := c.Requestf("/v1/contacts/%s", body.Id).
 		BodyJSON(body).
 		ToJSON(&cr).
-		ErrorJSON(&er).
 		Put().
 		Fetch(ctx)
 	if err != nil {
-		return cr, fmt.Errorf("error updating contacts (%s): %w", er.String(), err)
+		return cr, fmt.Errorf("error updating contacts: %w", err)
 	}
 
 	return cr, nil
 }
+
+// ModifyContact fetches a contact, applies modify to it and updates it.
+// When the update fails with a version conflict because the contact changed in between,
+// the contact is fetched again and modify applied again, see WithConflictRetries.
+// An error returned by modify aborts the update and is returned as is.
+func (c *Client) ModifyContact(ctx context.Context, id string, modify func(*ContactBody) error) (ContactsResponse, error) {
+	for attempt := 0; ; attempt++ {
+		cc, err := c.GetContact(ctx, id)
+		if err != nil {
+			return ContactsResponse{}, err
+		}
+
+		body := cc.Body()
+		if err := modify(&body); err != nil {
+			return ContactsResponse{}, err
+		}
+
+		cr, err := c.UpdateContact(ctx, body)
+		if errors.Is(err, ErrConflict) && attempt < c.conflictRetries {
+			continue
+		}
+
+		return cr, err
+	}
+}
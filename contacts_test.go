@@ -2,12 +2,17 @@ package golexoffice_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/aarondl/opt/omit"
 	lexoffice "github.com/karitham/go-lexoffice"
 	"github.com/stretchr/testify/assert"
 )
@@ -63,6 +68,45 @@ func TestGetContacts(t *testing.T) {
 	})
 }
 
+func TestGetContactsFilters(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		//nolint:errcheck
+		w.Write([]byte(`{"content": [], "first": true, "last": true}`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("filters=all", func(t *testing.T) {
+		_, err := config.GetContacts(context.Background(), lexoffice.GetContactsParams{
+			Email:  omit.From("inge@musterfrau.de"),
+			Name:   omit.From("Musterfrau"),
+			Number: omit.From(10308),
+		})
+		assert.NoError(t, err)
+
+		assert.False(t, query.Has("page"))
+		assert.Equal(t, "inge@musterfrau.de", query.Get("email"))
+		assert.Equal(t, "Musterfrau", query.Get("name"))
+		assert.Equal(t, "10308", query.Get("number"))
+	})
+
+	t.Run("filters=page", func(t *testing.T) {
+		_, err := config.GetContacts(context.Background(), lexoffice.GetContactsParams{
+			Page: omit.From(2),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "2", query.Get("page"))
+		assert.False(t, query.Has("email"))
+		assert.False(t, query.Has("name"))
+		assert.False(t, query.Has("number"))
+	})
+}
+
 func lexOfficeMock() *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodGet {
@@ -175,3 +219,85 @@ func TestLimiter(t *testing.T) {
 	elapsed := time.Since(start)
 	assert.GreaterOrEqual(t, elapsed.Milliseconds(), time.Second.Milliseconds()*3) // should be AT LEAST 3.5 seconds
 }
+
+func TestModifyContact(t *testing.T) {
+	// concurrent is how many times another worker updates the contact
+	// between our GET and our PUT
+	versionedMock := func(concurrent int) (*httptest.Server, *lexoffice.ContactBody) {
+		var mu sync.Mutex
+		stored := &lexoffice.ContactBody{
+			Id:      "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+			Version: 1,
+			Person:  &lexoffice.ContactBodyPerson{FirstName: "Inge", LastName: "Musterfrau"},
+		}
+
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			w.Header().Set("Content-Type", "application/json")
+			switch r.Method {
+			case http.MethodGet:
+				//nolint:errcheck
+				json.NewEncoder(w).Encode(stored)
+				if concurrent > 0 {
+					concurrent--
+					stored.Version++
+				}
+			case http.MethodPut:
+				var body lexoffice.ContactBody
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				if body.Version != stored.Version {
+					w.WriteHeader(http.StatusConflict)
+					//nolint:errcheck
+					w.Write([]byte(`{"requestId":"3fb21ee4-ad26-4e2f-82af-a1197af02d08","IssueList":[{"i18nKey":"optimistic_locking_failed","source":"version","type":"conflict"}]}`))
+					return
+				}
+
+				body.Version++
+				*stored = body
+				//nolint:errcheck
+				json.NewEncoder(w).Encode(lexoffice.ContactsResponse{ID: body.Id, Version: body.Version})
+			}
+		})), stored
+	}
+
+	setNote := func(b *lexoffice.ContactBody) error {
+		b.Note = "updated"
+		return nil
+	}
+
+	t.Run("conflicts=2", func(t *testing.T) {
+		server, stored := versionedMock(2)
+		defer server.Close()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL))
+		resp, err := c.ModifyContact(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", setNote)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, resp.Version)
+		assert.Equal(t, "updated", stored.Note)
+		assert.Equal(t, "Inge", stored.Person.FirstName)
+	})
+
+	t.Run("conflicts=limit", func(t *testing.T) {
+		server, stored := versionedMock(10)
+		defer server.Close()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL), lexoffice.WithConflictRetries(1))
+		_, err := c.ModifyContact(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", setNote)
+		assert.ErrorIs(t, err, lexoffice.ErrConflict)
+		assert.Empty(t, stored.Note)
+	})
+
+	t.Run("modify=error", func(t *testing.T) {
+		server, _ := versionedMock(0)
+		defer server.Close()
+
+		errAbort := errors.New("abort")
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL))
+		_, err := c.ModifyContact(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", func(b *lexoffice.ContactBody) error {
+			return errAbort
+		})
+		assert.ErrorIs(t, err, errAbort)
+	})
+}
@@ -0,0 +1,51 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// TaxClassification is the tax classification of a country, seen from germany.
+type TaxClassification string
+
+const (
+	TaxClassificationDomestic          TaxClassification = "de"
+	TaxClassificationIntraCommunity    TaxClassification = "intraCommunity"
+	TaxClassificationThirdPartyCountry TaxClassification = "thirdPartyCountry"
+)
+
+// Country is to decode json data
+type Country struct {
+	CountryCode       string            `json:"countryCode"`
+	CountryNameEN     string            `json:"countryNameEN"`
+	CountryNameDE     string            `json:"countryNameDE"`
+	TaxClassification TaxClassification `json:"taxClassification"`
+}
+
+// Countries is the list of countries known to lexoffice
+type Countries []Country
+
+// Lookup returns the country for a country code,
+// as used in InvoiceBodyAddress.CountryCode or ContactBodyBilling.CountryCode.
+func (cs Countries) Lookup(countryCode string) (Country, bool) {
+	for _, c := range cs {
+		if strings.EqualFold(c.CountryCode, countryCode) {
+			return c, true
+		}
+	}
+
+	return Country{}, false
+}
+
+// GetCountries is to get the list of all countries
+// <https://developers.lexoffice.io/docs/?shell#countries-endpoint-retrieve-list-of-countries>
+func (c *Client) GetCountries(ctx context.Context) (Countries, error) {
+	var cs Countries
+	err := c.Request("/v1/countries").ToJSON(&cs).Fetch(ctx)
+	if err != nil {
+		return cs, fmt.Errorf("error getting countries: %w", err)
+	}
+
+	return cs, nil
+}
@@ -0,0 +1,56 @@
+package golexoffice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCountries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/countries", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		//nolint:errcheck
+		w.Write([]byte(`[
+			{"countryCode": "DE", "countryNameEN": "Germany", "countryNameDE": "Deutschland", "taxClassification": "de"},
+			{"countryCode": "FR", "countryNameEN": "France", "countryNameDE": "Frankreich", "taxClassification": "intraCommunity"},
+			{"countryCode": "CH", "countryNameEN": "Switzerland", "countryNameDE": "Schweiz", "taxClassification": "thirdPartyCountry"}
+		]`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+	countries, err := config.GetCountries(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, countries, 3)
+
+	t.Run("lookup=domestic", func(t *testing.T) {
+		c, ok := countries.Lookup("DE")
+		assert.True(t, ok)
+		assert.Equal(t, "Deutschland", c.CountryNameDE)
+		assert.Equal(t, lexoffice.TaxClassificationDomestic, c.TaxClassification)
+	})
+
+	t.Run("lookup=case-insensitive", func(t *testing.T) {
+		c, ok := countries.Lookup("fr")
+		assert.True(t, ok)
+		assert.Equal(t, lexoffice.TaxClassificationIntraCommunity, c.TaxClassification)
+	})
+
+	t.Run("lookup=address", func(t *testing.T) {
+		address := lexoffice.InvoiceBodyAddress{CountryCode: "CH"}
+		c, ok := countries.Lookup(address.CountryCode)
+		assert.True(t, ok)
+		assert.Equal(t, lexoffice.TaxClassificationThirdPartyCountry, c.TaxClassification)
+	})
+
+	t.Run("lookup=unknown", func(t *testing.T) {
+		_, ok := countries.Lookup("AT")
+		assert.False(t, ok)
+	})
+}
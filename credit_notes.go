@@ -0,0 +1,107 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+)
+
+// CreditNoteBody is to define body data.
+// VoucherStatus is one of VoucherStatusDraft, VoucherStatusOpen,
+// VoucherStatusPaidOff or VoucherStatusVoided.
+type CreditNoteBody struct {
+	ID             string                   `json:"id,omitempty"`
+	OrganizationID string                   `json:"organizationId,omitempty"`
+	CreatedDate    Date                     `json:"createdDate,omitempty"`
+	UpdatedDate    Date                     `json:"updatedDate,omitempty"`
+	Version        int                      `json:"version,omitempty"`
+	Archived       bool                     `json:"archived,omitempty"`
+	VoucherStatus  VoucherStatus            `json:"voucherStatus,omitempty"`
+	VoucherNumber  string                   `json:"voucherNumber,omitempty"`
+	VoucherDate    Date                     `json:"voucherDate,omitempty"`
+	Address        InvoiceBodyAddress       `json:"address,omitempty"`
+	LineItems      []InvoiceBodyLineItems   `json:"lineItems,omitempty"`
+	TotalPrice     InvoiceBodyTotalPrice    `json:"totalPrice,omitempty"`
+	TaxAmounts     []InvoiceBodyTaxAmounts  `json:"taxAmounts,omitempty"`
+	TaxConditions  InvoiceBodyTaxConditions `json:"taxConditions,omitempty"`
+	Title          string                   `json:"title,omitempty"`
+	Introduction   string                   `json:"introduction,omitempty"`
+	Language       LanguageOption           `json:"language,omitempty"`
+	Remark         string                   `json:"remark,omitempty"`
+	Files          InvoiceBodyFiles         `json:"files,omitempty"`
+	PrintLayoutID  string                   `json:"printLayoutId,omitempty"`
+}
+
+// GetCreditNote is to get a credit note
+// <https://developers.lexoffice.io/docs/?shell#credit-notes-endpoint-retrieve-a-credit-note>
+func (c *Client) GetCreditNote(ctx context.Context, id string) (CreditNoteBody, error) {
+	var cb CreditNoteBody
+	err := c.Requestf("/v1/credit-notes/%s", id).ToJSON(&cb).Fetch(ctx)
+	if err != nil {
+		return cb, fmt.Errorf("error getting credit note: %w", err)
+	}
+
+	return cb, nil
+}
+
+// CreateCreditNoteOptions represent the set of possible options when creating a credit note.
+// if you provide a body, then the credit note will be created with the given body.
+// if you provide a preceding sales voucher id (usually an invoice),
+// then the credit note will be pursued from the sales voucher with the given id.
+type CreateCreditNoteOptions struct {
+	Finalize                bool
+	PrecedingSalesVoucherID string
+	Body                    CreditNoteBody
+}
+
+// CreateCreditNote is to create a new credit note, or to pursue a sales voucher to a credit note
+// <https://developers.lexoffice.io/docs/?shell#credit-notes-endpoint-create-a-credit-note> and
+// <https://developers.lexoffice.io/docs/?shell#credit-notes-endpoint-pursue-to-a-credit-note>
+func (c *Client) CreateCreditNote(ctx context.Context, o CreateCreditNoteOptions) (InvoiceResponse, error) {
+	var ir InvoiceResponse
+	qb := c.Request("/v1/credit-notes").ToJSON(&ir).Post()
+	if o.Finalize {
+		qb = qb.Param("finalize", "true")
+	}
+
+	if o.PrecedingSalesVoucherID != "" {
+		qb = qb.Param("precedingSalesVoucherId", o.PrecedingSalesVoucherID)
+	}
+
+	// an empty body is left out, so an invoice can be pursued by its id alone
+	if !reflect.ValueOf(o.Body).IsZero() {
+		qb = qb.BodyJSON(o.Body)
+	}
+
+	err := qb.Fetch(ctx)
+	if err != nil {
+		return ir, fmt.Errorf("error creating credit note: %w", err)
+	}
+
+	return ir, nil
+}
+
+// RenderCreditNotePDF is to render a credit note as pdf
+// <https://developers.lexoffice.io/docs/?shell#credit-notes-endpoint-render-a-credit-note-document-pdf>
+func (c *Client) RenderCreditNotePDF(ctx context.Context, creditNoteID string) (RenderResponse, error) {
+	var df RenderResponse
+	err := c.Requestf("/v1/credit-notes/%s/document", creditNoteID).ToJSON(&df).Fetch(ctx)
+	if err != nil {
+		return RenderResponse{}, fmt.Errorf("error getting document file id: %w", err)
+	}
+
+	return df, nil
+}
+
+// DeeplinkCreditNoteURL is to get the deeplink url for a credit note
+// <https://developers.lexoffice.io/docs/?shell#credit-notes-endpoint-deeplink-to-a-credit-note>
+func (c *Client) DeeplinkCreditNoteURL(ctx context.Context, creditNoteID string, edit bool) (string, error) {
+	arg := "view"
+	if edit {
+		arg = "edit"
+	}
+
+	p, _ := url.JoinPath("https://app.lexoffice.de/permalink/credit-notes", arg, creditNoteID)
+	return p, nil
+}
@@ -0,0 +1,130 @@
+package golexoffice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreditNotes(t *testing.T) {
+	server := creditNoteMock(t)
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("pursue", func(t *testing.T) {
+		resp, err := config.CreateCreditNote(context.Background(), lexoffice.CreateCreditNoteOptions{
+			Finalize:                true,
+			PrecedingSalesVoucherID: "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+			Body: lexoffice.CreditNoteBody{
+				Title: "Rechnungskorrektur",
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", resp.ID)
+	})
+
+	t.Run("pursue-without-body", func(t *testing.T) {
+		resp, err := config.CreateCreditNote(context.Background(), lexoffice.CreateCreditNoteOptions{
+			PrecedingSalesVoucherID: "b26e1d73-19ff-46b1-8929-09d8d73d0f5e",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", resp.ID)
+	})
+
+	t.Run("status=paidoff", func(t *testing.T) {
+		resp, err := config.GetCreditNote(context.Background(), "66196c43-baf3-4335-bfee-d610367059db")
+		assert.NoError(t, err)
+		assert.Equal(t, "GS0007", resp.VoucherNumber)
+		assert.Equal(t, lexoffice.VoucherStatusPaidOff, resp.VoucherStatus)
+	})
+
+	t.Run("status=voided", func(t *testing.T) {
+		resp, err := config.GetCreditNote(context.Background(), "424f784e-1f4e-439e-8f71-19673e6d6583")
+		assert.NoError(t, err)
+		assert.Equal(t, "GS0008", resp.VoucherNumber)
+		assert.Equal(t, lexoffice.VoucherStatusVoided, resp.VoucherStatus)
+	})
+
+	t.Run("render", func(t *testing.T) {
+		resp, err := config.RenderCreditNotePDF(context.Background(), "66196c43-baf3-4335-bfee-d610367059db")
+		assert.NoError(t, err)
+		assert.Equal(t, "7a1e3e7c-9d63-4a1f-8f16-0a2ebd6d0dcb", resp.ID)
+	})
+}
+
+func creditNoteMock(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/credit-notes" {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+
+			q := r.URL.Query()
+			switch q.Get("precedingSalesVoucherId") {
+			case "e9066f04-8cc7-4616-93f8-ac9ecc8479c8":
+				assert.Equal(t, "true", q.Get("finalize"))
+
+				var cb map[string]any
+				assert.NoError(t, json.Unmarshal(body, &cb))
+				assert.Equal(t, "Rechnungskorrektur", cb["title"])
+			case "b26e1d73-19ff-46b1-8929-09d8d73d0f5e":
+				assert.False(t, q.Has("finalize"))
+				assert.Empty(t, body)
+			default:
+				t.Errorf("unexpected precedingSalesVoucherId: %s", r.URL.RawQuery)
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+				"resourceUri": "https://api.lexoffice.io/v1/credit-notes/e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+				"createdDate": "2023-06-17T18:32:07.480+02:00",
+				"updatedDate": "2023-06-17T18:32:07.551+02:00",
+				"version": 1
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/credit-notes/66196c43-baf3-4335-bfee-d610367059db" {
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "66196c43-baf3-4335-bfee-d610367059db",
+				"voucherStatus": "paidoff",
+				"voucherNumber": "GS0007",
+				"voucherDate": "2023-06-17T00:00:00.000+02:00"
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/credit-notes/424f784e-1f4e-439e-8f71-19673e6d6583" {
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "424f784e-1f4e-439e-8f71-19673e6d6583",
+				"voucherStatus": "voided",
+				"voucherNumber": "GS0008",
+				"voucherDate": "2023-06-18T00:00:00.000+02:00"
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/credit-notes/66196c43-baf3-4335-bfee-d610367059db/document" {
+			//nolint:errcheck
+			w.Write([]byte(`{"documentFileId": "7a1e3e7c-9d63-4a1f-8f16-0a2ebd6d0dcb"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		//nolint:errcheck
+		w.Write([]byte(fmt.Sprintf(`{"message": "not found (%s): %s"}`, r.Method, r.RequestURI)))
+	}))
+}
@@ -0,0 +1,101 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+)
+
+// DeliveryNoteBody is to define body data.
+// Delivery notes carry no prices, so the line items should leave UnitPrice unset.
+type DeliveryNoteBody struct {
+	ID                 string                        `json:"id,omitempty"`
+	OrganizationID     string                        `json:"organizationId,omitempty"`
+	CreatedDate        Date                          `json:"createdDate,omitempty"`
+	UpdatedDate        Date                          `json:"updatedDate,omitempty"`
+	Version            int                           `json:"version,omitempty"`
+	Archived           bool                          `json:"archived,omitempty"`
+	VoucherStatus      VoucherStatus                 `json:"voucherStatus,omitempty"`
+	VoucherNumber      string                        `json:"voucherNumber,omitempty"`
+	VoucherDate        Date                          `json:"voucherDate,omitempty"`
+	Address            InvoiceBodyAddress            `json:"address,omitempty"`
+	LineItems          []InvoiceBodyLineItems        `json:"lineItems,omitempty"`
+	TaxConditions      InvoiceBodyTaxConditions      `json:"taxConditions,omitempty"`
+	ShippingConditions InvoiceBodyShippingConditions `json:"shippingConditions,omitempty"`
+	Title              string                        `json:"title,omitempty"`
+	Introduction       string                        `json:"introduction,omitempty"`
+	Language           LanguageOption                `json:"language,omitempty"`
+	Remark             string                        `json:"remark,omitempty"`
+	DeliveryTerms      string                        `json:"deliveryTerms,omitempty"`
+	Files              InvoiceBodyFiles              `json:"files,omitempty"`
+	PrintLayoutID      string                        `json:"printLayoutId,omitempty"`
+}
+
+// GetDeliveryNote is to get a delivery note
+// <https://developers.lexoffice.io/docs/?shell#delivery-notes-endpoint-retrieve-a-delivery-note>
+func (c *Client) GetDeliveryNote(ctx context.Context, id string) (DeliveryNoteBody, error) {
+	var db DeliveryNoteBody
+	err := c.Requestf("/v1/delivery-notes/%s", id).ToJSON(&db).Fetch(ctx)
+	if err != nil {
+		return db, fmt.Errorf("error getting delivery note: %w", err)
+	}
+
+	return db, nil
+}
+
+// CreateDeliveryNoteOptions represent the set of possible options when creating a delivery note.
+// if you provide a body, then the delivery note will be created with the given body.
+// if you provide a preceding sales voucher id (an order confirmation or an invoice),
+// then the delivery note will be pursued from the sales voucher with the given id.
+type CreateDeliveryNoteOptions struct {
+	PrecedingSalesVoucherID string
+	Body                    DeliveryNoteBody
+}
+
+// CreateDeliveryNote is to create a new delivery note, or to pursue a sales voucher to a delivery note
+// <https://developers.lexoffice.io/docs/?shell#delivery-notes-endpoint-create-a-delivery-note> and
+// <https://developers.lexoffice.io/docs/?shell#delivery-notes-endpoint-pursue-to-a-delivery-note>
+func (c *Client) CreateDeliveryNote(ctx context.Context, o CreateDeliveryNoteOptions) (InvoiceResponse, error) {
+	var ir InvoiceResponse
+	qb := c.Request("/v1/delivery-notes").ToJSON(&ir).Post()
+	if o.PrecedingSalesVoucherID != "" {
+		qb = qb.Param("precedingSalesVoucherId", o.PrecedingSalesVoucherID)
+	}
+
+	// an empty body is left out, so a sales voucher can be pursued by its id alone
+	if !reflect.ValueOf(o.Body).IsZero() {
+		qb = qb.BodyJSON(o.Body)
+	}
+
+	err := qb.Fetch(ctx)
+	if err != nil {
+		return ir, fmt.Errorf("error creating delivery note: %w", err)
+	}
+
+	return ir, nil
+}
+
+// RenderDeliveryNotePDF is to render a delivery note as pdf
+// <https://developers.lexoffice.io/docs/?shell#delivery-notes-endpoint-render-a-delivery-note-document-pdf>
+func (c *Client) RenderDeliveryNotePDF(ctx context.Context, deliveryNoteID string) (RenderResponse, error) {
+	var df RenderResponse
+	err := c.Requestf("/v1/delivery-notes/%s/document", deliveryNoteID).ToJSON(&df).Fetch(ctx)
+	if err != nil {
+		return RenderResponse{}, fmt.Errorf("error getting document file id: %w", err)
+	}
+
+	return df, nil
+}
+
+// DeeplinkDeliveryNoteURL is to get the deeplink url for a delivery note
+// <https://developers.lexoffice.io/docs/?shell#delivery-notes-endpoint-deeplink-to-a-delivery-note>
+func (c *Client) DeeplinkDeliveryNoteURL(ctx context.Context, deliveryNoteID string, edit bool) (string, error) {
+	arg := "view"
+	if edit {
+		arg = "edit"
+	}
+
+	p, _ := url.JoinPath("https://app.lexoffice.de/permalink/delivery-notes", arg, deliveryNoteID)
+	return p, nil
+}
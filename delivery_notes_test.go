@@ -0,0 +1,73 @@
+package golexoffice_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDeliveryNote(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			LineItems []map[string]any `json:"lineItems"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "/v1/delivery-notes", r.URL.Path)
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", r.URL.Query().Get("precedingSalesVoucherId"))
+		assert.Len(t, body.LineItems, 1)
+		assert.NotContains(t, body.LineItems[0], "unitPrice")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		//nolint:errcheck
+		w.Write([]byte(`{
+			"id": "66196c43-baf3-4335-bfee-d610367059db",
+			"resourceUri": "https://api.lexoffice.io/v1/delivery-notes/66196c43-baf3-4335-bfee-d610367059db",
+			"version": 1
+		}`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+	resp, err := config.CreateDeliveryNote(context.Background(), lexoffice.CreateDeliveryNoteOptions{
+		PrecedingSalesVoucherID: "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+		Body: lexoffice.DeliveryNoteBody{
+			LineItems: []lexoffice.InvoiceBodyLineItems{{
+				Type:     "custom",
+				Name:     "Energieriegel",
+				Quantity: 2,
+				UnitName: "Stück",
+			}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "66196c43-baf3-4335-bfee-d610367059db", resp.ID)
+}
+
+func TestPursueDeliveryNoteWithoutBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Empty(t, body)
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", r.URL.Query().Get("precedingSalesVoucherId"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		//nolint:errcheck
+		w.Write([]byte(`{"id": "66196c43-baf3-4335-bfee-d610367059db", "version": 1}`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+	resp, err := config.CreateDeliveryNote(context.Background(), lexoffice.CreateDeliveryNoteOptions{
+		PrecedingSalesVoucherID: "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "66196c43-baf3-4335-bfee-d610367059db", resp.ID)
+}
@@ -0,0 +1,47 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+)
+
+// DownPaymentInvoiceBody is to decode json data.
+// Down payment invoices are read-only through the API.
+type DownPaymentInvoiceBody struct {
+	ID                 string                        `json:"id,omitempty"`
+	OrganizationID     string                        `json:"organizationId,omitempty"`
+	CreatedDate        Date                          `json:"createdDate,omitempty"`
+	UpdatedDate        Date                          `json:"updatedDate,omitempty"`
+	Version            int                           `json:"version,omitempty"`
+	Archived           bool                          `json:"archived,omitempty"`
+	VoucherStatus      VoucherStatus                 `json:"voucherStatus,omitempty"`
+	VoucherNumber      string                        `json:"voucherNumber,omitempty"`
+	VoucherDate        Date                          `json:"voucherDate,omitempty"`
+	DueDate            Date                          `json:"dueDate,omitempty"`
+	Address            InvoiceBodyAddress            `json:"address,omitempty"`
+	LineItems          []InvoiceBodyLineItems        `json:"lineItems,omitempty"`
+	TotalPrice         InvoiceBodyTotalPrice         `json:"totalPrice,omitempty"`
+	TaxAmounts         []InvoiceBodyTaxAmounts       `json:"taxAmounts,omitempty"`
+	TaxConditions      InvoiceBodyTaxConditions      `json:"taxConditions,omitempty"`
+	PaymentConditions  InvoiceBodyPaymentConditions  `json:"paymentConditions,omitempty"`
+	ShippingConditions InvoiceBodyShippingConditions `json:"shippingConditions,omitempty"`
+	ClosingInvoiceID   string                        `json:"closingInvoiceId,omitempty"`
+	Title              string                        `json:"title,omitempty"`
+	Introduction       string                        `json:"introduction,omitempty"`
+	Language           LanguageOption                `json:"language,omitempty"`
+	Remark             string                        `json:"remark,omitempty"`
+	Files              InvoiceBodyFiles              `json:"files,omitempty"`
+	PrintLayoutID      string                        `json:"printLayoutId,omitempty"`
+}
+
+// GetDownPaymentInvoice is to get a down payment invoice
+// <https://developers.lexoffice.io/docs/?shell#down-payment-invoices-endpoint-retrieve-a-down-payment-invoice>
+func (c *Client) GetDownPaymentInvoice(ctx context.Context, id string) (DownPaymentInvoiceBody, error) {
+	var db DownPaymentInvoiceBody
+	err := c.Requestf("/v1/down-payment-invoices/%s", id).ToJSON(&db).Fetch(ctx)
+	if err != nil {
+		return db, fmt.Errorf("error getting down payment invoice: %w", err)
+	}
+
+	return db, nil
+}
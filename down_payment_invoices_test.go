@@ -0,0 +1,94 @@
+package golexoffice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDownPaymentInvoice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/down-payment-invoices/0a9ef8b3-b3e7-4c6c-b7c4-3a9d3e6b5d61", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		//nolint:errcheck
+		w.Write([]byte(`{
+			"id": "0a9ef8b3-b3e7-4c6c-b7c4-3a9d3e6b5d61",
+			"organizationId": "aa93e8a8-2aa3-470b-b914-caad8a255dd8",
+			"createdDate": "2023-01-17T15:28:35.129+01:00",
+			"updatedDate": "2023-01-17T15:28:59.327+01:00",
+			"version": 2,
+			"language": "de",
+			"archived": false,
+			"voucherStatus": "open",
+			"voucherNumber": "RE1019",
+			"voucherDate": "2023-01-17T00:00:00.000+01:00",
+			"dueDate": "2023-01-27T00:00:00.000+01:00",
+			"address": {
+				"contactId": "97c5794f-8ab2-43ad-b459-c5980b055e4d",
+				"name": "Berliner Kindl GmbH",
+				"street": "Jubiläumsweg 25",
+				"city": "Berlin",
+				"zip": "14089",
+				"countryCode": "DE"
+			},
+			"lineItems": [
+				{
+					"id": "3f2b6c6a-9a0f-4a1d-9f3b-2f1a5f0a0b1c",
+					"type": "custom",
+					"name": "Abschlagsrechnung vom 17.01.2023",
+					"quantity": 1,
+					"unitName": "Stück",
+					"unitPrice": {"currency": "EUR", "netAmount": 100.00, "grossAmount": 119.00, "taxRatePercentage": 19},
+					"discountPercentage": 0,
+					"lineItemAmount": 119.00
+				}
+			],
+			"totalPrice": {
+				"currency": "EUR",
+				"totalNetAmount": 100.00,
+				"totalGrossAmount": 119.00,
+				"totalTaxAmount": 19.00
+			},
+			"taxAmounts": [
+				{"taxRatePercentage": 19, "taxAmount": 19.00, "amount": 119.00}
+			],
+			"taxConditions": {"taxType": "gross"},
+			"paymentConditions": {"paymentTermLabel": "Zahlbar sofort, rein netto", "paymentTermDuration": 10},
+			"shippingConditions": {"shippingType": "none"},
+			"closingInvoiceId": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+			"title": "1. Abschlagsrechnung",
+			"introduction": "Wir erlauben uns, Ihnen folgende Abschlagszahlung in Rechnung zu stellen.",
+			"remark": "Vielen Dank für die gute Zusammenarbeit.",
+			"files": {"documentFileId": "7a1e3e7c-9d63-4a1f-8f16-0a2ebd6d0dcb"}
+		}`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+	resp, err := config.GetDownPaymentInvoice(context.Background(), "0a9ef8b3-b3e7-4c6c-b7c4-3a9d3e6b5d61")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "RE1019", resp.VoucherNumber)
+	assert.Equal(t, lexoffice.VoucherStatusOpen, resp.VoucherStatus)
+	assert.Equal(t, lexoffice.LanguageOptionDE, resp.Language)
+	assert.Equal(t, 27, time.Time(resp.DueDate).Day())
+	assert.Equal(t, "Berliner Kindl GmbH", resp.Address.Name)
+	assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", resp.ClosingInvoiceID)
+	assert.Equal(t, "7a1e3e7c-9d63-4a1f-8f16-0a2ebd6d0dcb", resp.Files.ID)
+	assert.Equal(t, 10, resp.PaymentConditions.PaymentTermDuration)
+	assert.True(t, decimal.NewFromInt(119).Equal(resp.TotalPrice.TotalGrossAmount))
+	if assert.Len(t, resp.LineItems, 1) && assert.NotNil(t, resp.LineItems[0].UnitPrice) {
+		assert.True(t, decimal.NewFromInt(100).Equal(resp.LineItems[0].UnitPrice.NetAmount))
+	}
+	if assert.Len(t, resp.TaxAmounts, 1) {
+		assert.True(t, decimal.NewFromInt(19).Equal(resp.TaxAmounts[0].TaxAmount))
+	}
+}
@@ -0,0 +1,85 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// DunningBody is to define body data.
+// Reminder fees go into LineItems, the same way as the items of an invoice.
+type DunningBody struct {
+	ID                 string                        `json:"id,omitempty"`
+	OrganizationID     string                        `json:"organizationId,omitempty"`
+	CreatedDate        Date                          `json:"createdDate,omitempty"`
+	UpdatedDate        Date                          `json:"updatedDate,omitempty"`
+	Version            int                           `json:"version,omitempty"`
+	Archived           bool                          `json:"archived,omitempty"`
+	VoucherDate        Date                          `json:"voucherDate,omitempty"`
+	Address            InvoiceBodyAddress            `json:"address,omitempty"`
+	LineItems          []InvoiceBodyLineItems        `json:"lineItems,omitempty"`
+	TotalPrice         InvoiceBodyTotalPrice         `json:"totalPrice,omitempty"`
+	TaxAmounts         []InvoiceBodyTaxAmounts       `json:"taxAmounts,omitempty"`
+	TaxConditions      InvoiceBodyTaxConditions      `json:"taxConditions,omitempty"`
+	ShippingConditions InvoiceBodyShippingConditions `json:"shippingConditions,omitempty"`
+	Title              string                        `json:"title,omitempty"`
+	Introduction       string                        `json:"introduction,omitempty"`
+	Language           LanguageOption                `json:"language,omitempty"`
+	Remark             string                        `json:"remark,omitempty"`
+	Files              InvoiceBodyFiles              `json:"files,omitempty"`
+	PrintLayoutID      string                        `json:"printLayoutId,omitempty"`
+}
+
+// GetDunning is to get a dunning
+// <https://developers.lexoffice.io/docs/?shell#dunnings-endpoint-retrieve-a-dunning>
+func (c *Client) GetDunning(ctx context.Context, id string) (DunningBody, error) {
+	var db DunningBody
+	err := c.Requestf("/v1/dunnings/%s", id).ToJSON(&db).Fetch(ctx)
+	if err != nil {
+		return db, fmt.Errorf("error getting dunning: %w", err)
+	}
+
+	return db, nil
+}
+
+// CreateDunning is to pursue an invoice to a dunning
+// <https://developers.lexoffice.io/docs/?shell#dunnings-endpoint-pursue-to-a-dunning>
+func (c *Client) CreateDunning(ctx context.Context, invoiceID string, body DunningBody) (InvoiceResponse, error) {
+	var ir InvoiceResponse
+	err := c.Request("/v1/dunnings").
+		Param("precedingSalesVoucherId", invoiceID).
+		BodyJSON(body).
+		ToJSON(&ir).
+		Post().
+		Fetch(ctx)
+	if err != nil {
+		return ir, fmt.Errorf("error creating dunning: %w", err)
+	}
+
+	return ir, nil
+}
+
+// RenderDunningPDF is to render a dunning as pdf.
+// The returned document file id can be passed to DownloadFile.
+// <https://developers.lexoffice.io/docs/?shell#dunnings-endpoint-render-a-dunning-document-pdf>
+func (c *Client) RenderDunningPDF(ctx context.Context, dunningID string) (RenderResponse, error) {
+	var df RenderResponse
+	err := c.Requestf("/v1/dunnings/%s/document", dunningID).ToJSON(&df).Fetch(ctx)
+	if err != nil {
+		return RenderResponse{}, fmt.Errorf("error getting document file id: %w", err)
+	}
+
+	return df, nil
+}
+
+// DeeplinkDunningURL is to get the deeplink url for a dunning
+// <https://developers.lexoffice.io/docs/?shell#dunnings-endpoint-deeplink-to-a-dunning>
+func (c *Client) DeeplinkDunningURL(ctx context.Context, dunningID string, edit bool) (string, error) {
+	arg := "view"
+	if edit {
+		arg = "edit"
+	}
+
+	p, _ := url.JoinPath("https://app.lexoffice.de/permalink/dunnings", arg, dunningID)
+	return p, nil
+}
@@ -0,0 +1,120 @@
+package golexoffice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDunnings(t *testing.T) {
+	server := dunningMock(t)
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := config.CreateDunning(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", lexoffice.DunningBody{
+			Title: "Mahnung",
+			LineItems: []lexoffice.InvoiceBodyLineItems{{
+				Type:     "custom",
+				Name:     "Mahngebühr",
+				Quantity: 1,
+				UnitName: "Stück",
+				UnitPrice: &lexoffice.InvoiceBodyUnitPrice{
+					Currency:          "EUR",
+					NetAmount:         decimal.RequireFromString("5.00"),
+					TaxRatePercentage: 0,
+				},
+			}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "424f784e-1f4e-439e-8f71-19673e6d6583", resp.ID)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		resp, err := config.GetDunning(context.Background(), "424f784e-1f4e-439e-8f71-19673e6d6583")
+		assert.NoError(t, err)
+		assert.Equal(t, "Mahnung", resp.Title)
+		if assert.Len(t, resp.LineItems, 1) && assert.NotNil(t, resp.LineItems[0].UnitPrice) {
+			assert.True(t, decimal.NewFromInt(5).Equal(resp.LineItems[0].UnitPrice.NetAmount))
+		}
+	})
+
+	t.Run("render", func(t *testing.T) {
+		resp, err := config.RenderDunningPDF(context.Background(), "424f784e-1f4e-439e-8f71-19673e6d6583")
+		assert.NoError(t, err)
+		assert.Equal(t, "b26e1d73-19ff-46b1-8929-09d8d73d0f5e", resp.ID)
+	})
+}
+
+func dunningMock(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/dunnings" {
+			assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", r.URL.Query().Get("precedingSalesVoucherId"))
+
+			var body struct {
+				LineItems []struct {
+					Name      string `json:"name"`
+					UnitPrice struct {
+						Currency  string `json:"currency"`
+						NetAmount string `json:"netAmount"`
+					} `json:"unitPrice"`
+				} `json:"lineItems"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			if assert.Len(t, body.LineItems, 1) {
+				assert.Equal(t, "Mahngebühr", body.LineItems[0].Name)
+				assert.Equal(t, "EUR", body.LineItems[0].UnitPrice.Currency)
+				assert.Equal(t, "5", body.LineItems[0].UnitPrice.NetAmount)
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "424f784e-1f4e-439e-8f71-19673e6d6583",
+				"resourceUri": "https://api.lexoffice.io/v1/dunnings/424f784e-1f4e-439e-8f71-19673e6d6583",
+				"createdDate": "2023-02-21T00:00:00.000+01:00",
+				"updatedDate": "2023-02-21T00:00:00.000+01:00",
+				"version": 1
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/dunnings/424f784e-1f4e-439e-8f71-19673e6d6583" {
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "424f784e-1f4e-439e-8f71-19673e6d6583",
+				"voucherDate": "2023-02-22T00:00:00.000+01:00",
+				"title": "Mahnung",
+				"lineItems": [{
+					"type": "custom",
+					"name": "Mahngebühr",
+					"quantity": 1,
+					"unitName": "Stück",
+					"unitPrice": {"currency": "EUR", "netAmount": 5.00, "grossAmount": 5.00, "taxRatePercentage": 0},
+					"lineItemAmount": 5.00
+				}]
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/dunnings/424f784e-1f4e-439e-8f71-19673e6d6583/document" {
+			//nolint:errcheck
+			w.Write([]byte(`{"documentFileId": "b26e1d73-19ff-46b1-8929-09d8d73d0f5e"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		//nolint:errcheck
+		w.Write([]byte(fmt.Sprintf(`{"message": "not found (%s): %s"}`, r.Method, r.RequestURI)))
+	}))
+}
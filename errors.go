@@ -1,9 +1,16 @@
 package golexoffice
 
-import "strings"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
 
+// LegacyErrorResponse is the legacy error response of lexoffice.
 // source: https://developers.lexoffice.io/docs/#error-codes-legacy-error-response
-// files, profile, contacts
 //
 //	{
 //		"requestId":"3fb21ee4-ad26-4e2f-82af-a1197af02d08",
@@ -12,6 +19,8 @@ import "strings"
 //			{"i18nKey":"missing_entity","source":"company.name","type":"validation_failure"}
 //		]
 //	}
+//
+// Deprecated: endpoints return an *APIError, which normalizes both error responses.
 type LegacyErrorResponse struct {
 	RequestID string `json:"requestId"`
 	IssueList []struct {
@@ -45,8 +54,8 @@ func (e LegacyErrorResponse) String() string {
 	return builder.String()
 }
 
+// ErrorResponse is the regular error response of lexoffice.
 // source: https://developers.lexoffice.io/docs/#error-codes-regular-error-response
-// event-subscription, invoices
 //
 //	{
 //		"timestamp": "2017-05-11T17:12:31.233+02:00",
@@ -63,6 +72,8 @@ func (e LegacyErrorResponse) String() string {
 //			}
 //		]
 //	}
+//
+// Deprecated: endpoints return an *APIError, which normalizes both error responses.
 type ErrorResponse struct {
 	Timestamp   Date   `json:"timestamp"`
 	Status      int    `json:"status"`
@@ -108,3 +119,153 @@ func (e ErrorResponse) String() string {
 
 	return builder.String()
 }
+
+var (
+	// ErrNotFound matches an APIError with status 404
+	ErrNotFound = errors.New("not found")
+	// ErrConflict matches an APIError with status 409, usually an outdated version
+	ErrConflict = errors.New("conflict")
+	// ErrValidation matches an APIError with status 400, 406 or 422
+	ErrValidation = errors.New("validation failed")
+	// ErrRateLimited matches an APIError with status 429
+	ErrRateLimited = errors.New("rate limited")
+	// ErrUnauthorized matches an APIError with status 401 or 403
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+// APIError is returned by every endpoint when lexoffice answers with a non 2xx status.
+// It normalizes both the legacy and the regular error response.
+//
+//	var apiErr *APIError
+//	if errors.As(err, &apiErr) {
+//		log.Println(apiErr.StatusCode, apiErr.Issues)
+//	}
+//
+//	if errors.Is(err, ErrNotFound) {
+//		...
+//	}
+type APIError struct {
+	StatusCode int
+	// RequestID is the requestId of a legacy error response, or the traceId of a regular one
+	RequestID string
+	Method    string
+	Endpoint  string
+	Message   string
+	Issues    []Issue
+}
+
+// Issue is a single problem reported by lexoffice
+type Issue struct {
+	// Key is the i18nKey of a legacy error response, or the violation of a regular one
+	Key string
+	// Field is the source of a legacy error response, or the field of a regular one
+	Field   string
+	Type    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	builder := &strings.Builder{}
+	fmt.Fprintf(builder, "%s %s: %d %s", e.Method, e.Endpoint, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message != "" {
+		builder.WriteString(": ")
+		builder.WriteString(e.Message)
+	}
+
+	if len(e.Issues) == 0 {
+		return builder.String()
+	}
+
+	builder.WriteString(" (")
+	for i, issue := range e.Issues {
+		builder.WriteString(issue.Field)
+		builder.WriteString(": ")
+		builder.WriteString(issue.Key)
+		if issue.Message != "" {
+			builder.WriteString(" ")
+			builder.WriteString(issue.Message)
+		}
+
+		if i < len(e.Issues)-1 {
+			builder.WriteString(", ")
+		}
+	}
+	builder.WriteString(")")
+
+	return builder.String()
+}
+
+// Is classifies the error by its status code, see ErrNotFound and the other sentinels.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest ||
+			e.StatusCode == http.StatusNotAcceptable ||
+			e.StatusCode == http.StatusUnprocessableEntity
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	default:
+		return false
+	}
+}
+
+// checkError is a validator turning non 2xx responses into an *APIError
+func checkError(res *http.Response) error {
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+
+	e := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     res.Request.Method,
+		Endpoint:   res.Request.URL.Path,
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil || len(body) == 0 {
+		return e
+	}
+
+	// the body is either a legacy or a regular error response
+	var eb struct {
+		RequestID string `json:"requestId"`
+		IssueList []struct {
+			Key    string `json:"i18nKey"`
+			Source string `json:"source"`
+			Type   string `json:"type"`
+		} `json:"IssueList"`
+		TraceID string `json:"traceId"`
+		Message string `json:"message"`
+		Details []struct {
+			Violation string `json:"violation"`
+			Field     string `json:"field"`
+			Message   string `json:"message"`
+		} `json:"details"`
+	}
+	if err := json.Unmarshal(body, &eb); err != nil {
+		e.Message = strings.TrimSpace(string(body))
+		return e
+	}
+
+	e.RequestID = eb.RequestID
+	if e.RequestID == "" {
+		e.RequestID = eb.TraceID
+	}
+
+	e.Message = eb.Message
+	for _, issue := range eb.IssueList {
+		e.Issues = append(e.Issues, Issue{Key: issue.Key, Field: issue.Source, Type: issue.Type})
+	}
+
+	for _, detail := range eb.Details {
+		e.Issues = append(e.Issues, Issue{Key: detail.Violation, Field: detail.Field, Message: detail.Message})
+	}
+
+	return e
+}
@@ -2,6 +2,7 @@ package golexoffice_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -28,12 +29,50 @@ func TestErrorResponse(t *testing.T) {
 				TaxNumber:         "",
 			},
 		})
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, lexoffice.ErrValidation)
+
+		var apiErr *lexoffice.APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, 400, apiErr.StatusCode)
+		assert.Equal(t, "75d4dad6-6ccb-40fd-8c22-797f2d421d98", apiErr.RequestID)
+		assert.Equal(t, "POST", apiErr.Method)
+		assert.Equal(t, "/v1/contacts", apiErr.Endpoint)
+		assert.Equal(t, []lexoffice.Issue{
+			{Key: "missing_entity", Field: "company.vatRegistrationId", Type: "validation_failure"},
+			{Key: "missing_entity", Field: "company.taxNumber", Type: "validation_failure"},
+		}, apiErr.Issues)
 	})
 
 	t.Run("errors=new", func(t *testing.T) {
 		_, err := config.CreateInvoice(context.Background(), lexoffice.CreateInvoiceOptions{})
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, lexoffice.ErrValidation)
+
+		var apiErr *lexoffice.APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, 406, apiErr.StatusCode)
+		assert.Equal(t, "90d78d0777be", apiErr.RequestID)
+		assert.Equal(t, "Validation failed for request. Please see details list for specific causes.", apiErr.Message)
+		assert.Equal(t, []lexoffice.Issue{
+			{Key: "NOTNULL", Field: "lineItems[0].unitPrice.taxRatePercentage", Message: "darf nicht leer sein"},
+		}, apiErr.Issues)
+	})
+
+	t.Run("errors=sentinels", func(t *testing.T) {
+		cases := map[string]error{
+			"/v1/invoices/404": lexoffice.ErrNotFound,
+			"/v1/invoices/409": lexoffice.ErrConflict,
+			"/v1/invoices/429": lexoffice.ErrRateLimited,
+			"/v1/invoices/401": lexoffice.ErrUnauthorized,
+		}
+		for path, sentinel := range cases {
+			_, err := config.GetInvoice(context.Background(), path[len("/v1/invoices/"):])
+			assert.ErrorIs(t, err, sentinel, path)
+			for _, other := range cases {
+				if other != sentinel {
+					assert.NotErrorIs(t, err, other, path)
+				}
+			}
+		}
 	})
 
 }
@@ -72,6 +111,15 @@ func errorMock() *httptest.Server {
 			}`))
 			return
 		}
-		w.WriteHeader(http.StatusNotFound)
+		switch r.URL.Path {
+		case "/v1/invoices/409":
+			w.WriteHeader(http.StatusConflict)
+		case "/v1/invoices/429":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/v1/invoices/401":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
 	}))
 }
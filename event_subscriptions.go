@@ -0,0 +1,137 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+)
+
+// EventType is the type of event a subscription listens to.
+type EventType string
+
+const (
+	EventTypeArticleCreated EventType = "article.created"
+	EventTypeArticleChanged EventType = "article.changed"
+	EventTypeArticleDeleted EventType = "article.deleted"
+
+	EventTypeContactCreated EventType = "contact.created"
+	EventTypeContactChanged EventType = "contact.changed"
+	EventTypeContactDeleted EventType = "contact.deleted"
+
+	EventTypeCreditNoteCreated       EventType = "credit-note.created"
+	EventTypeCreditNoteChanged       EventType = "credit-note.changed"
+	EventTypeCreditNoteDeleted       EventType = "credit-note.deleted"
+	EventTypeCreditNoteStatusChanged EventType = "credit-note.status.changed"
+
+	EventTypeDeliveryNoteCreated       EventType = "delivery-note.created"
+	EventTypeDeliveryNoteChanged       EventType = "delivery-note.changed"
+	EventTypeDeliveryNoteDeleted       EventType = "delivery-note.deleted"
+	EventTypeDeliveryNoteStatusChanged EventType = "delivery-note.status.changed"
+
+	EventTypeDownPaymentInvoiceCreated       EventType = "down-payment-invoice.created"
+	EventTypeDownPaymentInvoiceChanged       EventType = "down-payment-invoice.changed"
+	EventTypeDownPaymentInvoiceDeleted       EventType = "down-payment-invoice.deleted"
+	EventTypeDownPaymentInvoiceStatusChanged EventType = "down-payment-invoice.status.changed"
+
+	EventTypeDunningCreated EventType = "dunning.created"
+	EventTypeDunningChanged EventType = "dunning.changed"
+	EventTypeDunningDeleted EventType = "dunning.deleted"
+
+	EventTypeInvoiceCreated       EventType = "invoice.created"
+	EventTypeInvoiceChanged       EventType = "invoice.changed"
+	EventTypeInvoiceDeleted       EventType = "invoice.deleted"
+	EventTypeInvoiceStatusChanged EventType = "invoice.status.changed"
+
+	EventTypeOrderConfirmationCreated       EventType = "order-confirmation.created"
+	EventTypeOrderConfirmationChanged       EventType = "order-confirmation.changed"
+	EventTypeOrderConfirmationDeleted       EventType = "order-confirmation.deleted"
+	EventTypeOrderConfirmationStatusChanged EventType = "order-confirmation.status.changed"
+
+	EventTypePaymentChanged EventType = "payment.changed"
+
+	EventTypeQuotationCreated       EventType = "quotation.created"
+	EventTypeQuotationChanged       EventType = "quotation.changed"
+	EventTypeQuotationDeleted       EventType = "quotation.deleted"
+	EventTypeQuotationStatusChanged EventType = "quotation.status.changed"
+
+	EventTypeRecurringTemplateCreated EventType = "recurring-template.created"
+	EventTypeRecurringTemplateChanged EventType = "recurring-template.changed"
+	EventTypeRecurringTemplateDeleted EventType = "recurring-template.deleted"
+
+	EventTypeTokenRevoked EventType = "token.revoked"
+
+	EventTypeVoucherCreated       EventType = "voucher.created"
+	EventTypeVoucherChanged       EventType = "voucher.changed"
+	EventTypeVoucherDeleted       EventType = "voucher.deleted"
+	EventTypeVoucherStatusChanged EventType = "voucher.status.changed"
+)
+
+// EventSubscriptionBody is to create a new event subscription
+type EventSubscriptionBody struct {
+	EventType   EventType `json:"eventType"`
+	CallbackURL string    `json:"callbackUrl"`
+}
+
+// EventSubscription is to decode json data
+type EventSubscription struct {
+	SubscriptionID string    `json:"subscriptionId"`
+	OrganizationID string    `json:"organizationId"`
+	CreatedDate    Date      `json:"createdDate"`
+	EventType      EventType `json:"eventType"`
+	CallbackURL    string    `json:"callbackUrl"`
+}
+
+// EventSubscriptionsReturn is to decode json data
+type EventSubscriptionsReturn struct {
+	Content []EventSubscription `json:"content"`
+}
+
+// CreateEventSubscription creates a new event subscription
+// <https://developers.lexoffice.io/docs/?shell#event-subscriptions-endpoint-create-an-event-subscription>
+func (c *Client) CreateEventSubscription(ctx context.Context, body EventSubscriptionBody) (InvoiceResponse, error) {
+	var ir InvoiceResponse
+	err := c.Request("/v1/event-subscriptions").
+		BodyJSON(body).
+		ToJSON(&ir).
+		Post().
+		Fetch(ctx)
+	if err != nil {
+		return ir, fmt.Errorf("error creating event subscription: %w", err)
+	}
+
+	return ir, nil
+}
+
+// GetEventSubscription is to get an event subscription by id
+// <https://developers.lexoffice.io/docs/?shell#event-subscriptions-endpoint-retrieve-an-event-subscription>
+func (c *Client) GetEventSubscription(ctx context.Context, id string) (EventSubscription, error) {
+	var es EventSubscription
+	err := c.Requestf("/v1/event-subscriptions/%s", id).ToJSON(&es).Fetch(ctx)
+	if err != nil {
+		return es, fmt.Errorf("error getting event subscription: %w", err)
+	}
+
+	return es, nil
+}
+
+// GetEventSubscriptions is to get a list of all event subscriptions
+// <https://developers.lexoffice.io/docs/?shell#event-subscriptions-endpoint-retrieve-all-event-subscriptions>
+func (c *Client) GetEventSubscriptions(ctx context.Context) (EventSubscriptionsReturn, error) {
+	var esr EventSubscriptionsReturn
+	err := c.Request("/v1/event-subscriptions").ToJSON(&esr).Fetch(ctx)
+	if err != nil {
+		return esr, fmt.Errorf("error getting event subscriptions: %w", err)
+	}
+
+	return esr, nil
+}
+
+// DeleteEventSubscription deletes an event subscription
+// <https://developers.lexoffice.io/docs/?shell#event-subscriptions-endpoint-delete-an-event-subscription>
+func (c *Client) DeleteEventSubscription(ctx context.Context, id string) error {
+	err := c.Requestf("/v1/event-subscriptions/%s", id).Delete().Fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting event subscription: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package golexoffice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventSubscriptions(t *testing.T) {
+	var deleted bool
+	server := eventSubscriptionMock(t, &deleted)
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := config.CreateEventSubscription(context.Background(), lexoffice.EventSubscriptionBody{
+			EventType:   lexoffice.EventTypeContactChanged,
+			CallbackURL: "https://example.org/webhook",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "8aea23f2-1bde-4ec5-b06a-8e4e4e15c9a3", resp.ID)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := config.GetEventSubscriptions(context.Background())
+		assert.NoError(t, err)
+		if assert.Len(t, resp.Content, 1) {
+			assert.Equal(t, lexoffice.EventTypeContactChanged, resp.Content[0].EventType)
+		}
+	})
+
+	t.Run("get", func(t *testing.T) {
+		resp, err := config.GetEventSubscription(context.Background(), "8aea23f2-1bde-4ec5-b06a-8e4e4e15c9a3")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.org/webhook", resp.CallbackURL)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err := config.DeleteEventSubscription(context.Background(), "8aea23f2-1bde-4ec5-b06a-8e4e4e15c9a3")
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+	})
+}
+
+func eventSubscriptionMock(t *testing.T, deleted *bool) *httptest.Server {
+	subscription := `{
+		"subscriptionId": "8aea23f2-1bde-4ec5-b06a-8e4e4e15c9a3",
+		"organizationId": "aa93e8a8-2aa3-470b-b914-caad8a255dd8",
+		"createdDate": "2023-04-11T10:48:18.000+02:00",
+		"eventType": "contact.changed",
+		"callbackUrl": "https://example.org/webhook"
+	}`
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/event-subscriptions" {
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "contact.changed", body["eventType"])
+			assert.Equal(t, "https://example.org/webhook", body["callbackUrl"])
+
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "8aea23f2-1bde-4ec5-b06a-8e4e4e15c9a3",
+				"resourceUri": "https://api.lexoffice.io/v1/event-subscriptions/8aea23f2-1bde-4ec5-b06a-8e4e4e15c9a3",
+				"createdDate": "2023-04-11T10:48:18.000+02:00",
+				"updatedDate": "2023-04-11T10:48:18.000+02:00",
+				"version": 0
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/event-subscriptions" {
+			//nolint:errcheck
+			w.Write([]byte(`{"content": [` + subscription + `]}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/event-subscriptions/8aea23f2-1bde-4ec5-b06a-8e4e4e15c9a3" {
+			//nolint:errcheck
+			w.Write([]byte(subscription))
+			return
+		}
+
+		if r.Method == http.MethodDelete && r.URL.Path == "/v1/event-subscriptions/8aea23f2-1bde-4ec5-b06a-8e4e4e15c9a3" {
+			*deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		//nolint:errcheck
+		w.Write([]byte(fmt.Sprintf(`{"message": "not found (%s): %s"}`, r.Method, r.RequestURI)))
+	}))
+}
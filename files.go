@@ -24,36 +24,19 @@ type CreateFileResponse struct {
 // CreateFile uploads a file
 // <https://developers.lexoffice.io/docs/?shell#files-endpoint-upload-a-file>
 func (c *Client) CreateFile(ctx context.Context, r io.Reader, name string) (CreateFileResponse, error) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-
-	filePart, err := writer.CreateFormFile("file", name)
-	if err != nil {
-		return CreateFileResponse{}, err
-	}
-
-	_, err = io.Copy(filePart, r)
+	body, contentType, err := voucherFileForm(r, name)
 	if err != nil {
 		return CreateFileResponse{}, err
 	}
 
-	_ = writer.WriteField("type", "voucher")
-
-	err = writer.Close()
-	if err != nil {
-		return CreateFileResponse{}, err
-	}
-
-	var er LegacyErrorResponse
 	var fr CreateFileResponse
 	err = c.Request("/v1/files").
-		ContentType(writer.FormDataContentType()).
-		BodyReader(body).
+		ContentType(contentType).
+		BodyBytes(body).
 		ToJSON(&fr).
-		ErrorJSON(&er).
 		Fetch(ctx)
 	if err != nil {
-		return fr, fmt.Errorf("error while request (%s): %w", er, err)
+		return fr, fmt.Errorf("error while request: %w", err)
 	}
 
 	return fr, nil
@@ -62,15 +45,38 @@ func (c *Client) CreateFile(ctx context.Context, r io.Reader, name string) (Crea
 // DownloadFile downloads a file
 // <https://developers.lexoffice.io/docs/?shell#files-endpoint-download-a-file>
 func (c *Client) DownloadFile(ctx context.Context, out io.Writer, id string) error {
-	var er LegacyErrorResponse
 	err := c.Requestf("/v1/files/%s", id).
 		Header("Accept", "application/octet-stream", "application/pdf").
-		ErrorJSON(&er).
 		ToWriter(out).
 		Fetch(ctx)
 	if err != nil {
-		return fmt.Errorf("error while request (%s): %w", er, err)
+		return fmt.Errorf("error while request: %w", err)
 	}
 
 	return nil
 }
+
+// voucherFileForm builds the multipart form used to upload a voucher file
+func voucherFileForm(r io.Reader, name string) ([]byte, string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	filePart, err := writer.CreateFormFile("file", name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, err = io.Copy(filePart, r)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_ = writer.WriteField("type", "voucher")
+
+	err = writer.Close()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body.Bytes(), writer.FormDataContentType(), nil
+}
@@ -15,6 +15,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"mime"
+	"net/http"
 	"net/url"
 	"time"
 
@@ -29,6 +32,29 @@ const (
 	LanguageOptionDE LanguageOption = "de"
 )
 
+// VoucherStatus is the status of a sales voucher.
+type VoucherStatus string
+
+const (
+	VoucherStatusDraft   VoucherStatus = "draft"
+	VoucherStatusOpen    VoucherStatus = "open"
+	VoucherStatusPaid    VoucherStatus = "paid"
+	VoucherStatusPaidOff VoucherStatus = "paidoff"
+	VoucherStatusVoided  VoucherStatus = "voided"
+
+	// only used by invoices
+	VoucherStatusOverdue VoucherStatus = "overdue"
+
+	// only used by quotations
+	VoucherStatusAccepted VoucherStatus = "accepted"
+	VoucherStatusRejected VoucherStatus = "rejected"
+
+	// only used by bookkeeping vouchers
+	VoucherStatusTransferred VoucherStatus = "transferred"
+	VoucherStatusSepaDebit   VoucherStatus = "sepadebit"
+	VoucherStatusUnchecked   VoucherStatus = "unchecked"
+)
+
 const DateFormat = "2006-01-02T15:04:05.000-07:00"
 
 type Date time.Time
@@ -47,6 +73,26 @@ func (t Date) MarshalJSON() ([]byte, error) {
 	return fmt.Appendf(nil, "\"%s\"", time.Time(t).Format(DateFormat)), nil
 }
 
+// DateOnlyFormat is the format of the dates lexoffice sends without a time,
+// such as the recurring template settings.
+const DateOnlyFormat = "2006-01-02"
+
+type DateOnly time.Time
+
+func (t *DateOnly) UnmarshalJSON(b []byte) error {
+	b = bytes.Trim(b, "\"")
+	tt, err := time.Parse(DateOnlyFormat, string(b))
+	if err != nil {
+		return err
+	}
+	*t = DateOnly(tt)
+	return nil
+}
+
+func (t DateOnly) MarshalJSON() ([]byte, error) {
+	return fmt.Appendf(nil, "\"%s\"", time.Time(t).Format(DateOnlyFormat)), nil
+}
+
 // InvoiceBody is to define body data
 type InvoiceBody struct {
 	ID                 string                        `json:"id,omitempty"`
@@ -71,6 +117,26 @@ type InvoiceBody struct {
 	Language           LanguageOption                `json:"language,omitempty"`
 	Remark             string                        `json:"remark,omitempty"`
 	Files              InvoiceBodyFiles              `json:"files,omitempty"`
+	PrintLayoutID      string                        `json:"printLayoutId,omitempty"`
+	XRechnung          *XRechnung                    `json:"xRechnung,omitempty"`
+
+	// ClosingInvoice and DownPaymentDeductions are read-only,
+	// they are set when the invoice settles previous down payment invoices.
+	ClosingInvoice        bool                               `json:"closingInvoice,omitempty"`
+	DownPaymentDeductions []InvoiceBodyDownPaymentDeductions `json:"downPaymentDeductions,omitempty"`
+}
+
+// InvoiceBodyDownPaymentDeductions is a down payment invoice deducted by a closing invoice
+type InvoiceBodyDownPaymentDeductions struct {
+	ID                  string          `json:"id,omitempty"`
+	VoucherType         string          `json:"voucherType,omitempty"`
+	Title               string          `json:"title,omitempty"`
+	VoucherNumber       string          `json:"voucherNumber,omitempty"`
+	VoucherDate         Date            `json:"voucherDate,omitempty"`
+	ReceivedGrossAmount decimal.Decimal `json:"receivedGrossAmount,omitempty"`
+	ReceivedNetAmount   decimal.Decimal `json:"receivedNetAmount,omitempty"`
+	ReceivedTaxAmount   decimal.Decimal `json:"receivedTaxAmount,omitempty"`
+	TaxRatePercentage   float64         `json:"taxRatePercentage,omitempty"`
 }
 
 type InvoiceBodyFiles struct {
@@ -88,15 +154,18 @@ type InvoiceBodyAddress struct {
 }
 
 type InvoiceBodyLineItems struct {
-	Id                 string               `json:"id,omitempty"`
-	Type               string               `json:"type,omitempty"`
-	Name               string               `json:"name,omitempty"`
-	Description        string               `json:"description,omitempty"`
-	Quantity           float64              `json:"quantity,omitempty"`
-	UnitName           string               `json:"unitName,omitempty"`
-	UnitPrice          InvoiceBodyUnitPrice `json:"unitPrice,omitempty"`
-	DiscountPercentage float64              `json:"discountPercentage,omitempty"`
-	LineItemAmount     float64              `json:"lineItemAmount,omitempty"`
+	Id          string  `json:"id,omitempty"`
+	Type        string  `json:"type,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Quantity    float64 `json:"quantity,omitempty"`
+	UnitName    string  `json:"unitName,omitempty"`
+
+	// UnitPrice is left unset for line items without a price,
+	// such as text items or the items of a delivery note.
+	UnitPrice          *InvoiceBodyUnitPrice `json:"unitPrice,omitempty"`
+	DiscountPercentage float64               `json:"discountPercentage,omitempty"`
+	LineItemAmount     float64               `json:"lineItemAmount,omitempty"`
 }
 
 type InvoiceBodyUnitPrice struct {
@@ -134,8 +203,8 @@ type InvoiceBodyPaymentConditions struct {
 }
 
 type InvoiceBodyPaymentDiscountConditions struct {
-	DiscountPercentage int `json:"discountPercentage,omitempty"`
-	DiscountRange      int `json:"discountRange,omitempty"`
+	DiscountPercentage float64 `json:"discountPercentage,omitempty"`
+	DiscountRange      int     `json:"discountRange,omitempty"`
 }
 
 type InvoiceBodyShippingConditions struct {
@@ -157,10 +226,9 @@ type InvoiceResponse struct {
 // <https://developers.lexoffice.io/docs/?shell#invoices-endpoint-retrieve-an-invoice>
 func (c *Client) GetInvoice(ctx context.Context, id string) (InvoiceBody, error) {
 	var ib InvoiceBody
-	var er ErrorResponse
-	err := c.Requestf("/v1/invoices/%s", id).ToJSON(&ib).ErrorJSON(&er).Fetch(ctx)
+	err := c.Requestf("/v1/invoices/%s", id).ToJSON(&ib).Fetch(ctx)
 	if err != nil {
-		return ib, fmt.Errorf("error getting invoice (%s): %w", er.String(), err)
+		return ib, fmt.Errorf("error getting invoice: %w", err)
 	}
 
 	return ib, nil
@@ -170,6 +238,8 @@ func (c *Client) GetInvoice(ctx context.Context, id string) (InvoiceBody, error)
 // if you provide a body, then the invoice will be created with the given body.
 // if you provide a preceding sales voucher id,
 // then the invoice will be created from the sales voucher with the given id.
+// To create an XRechnung, set Body.XRechnung and Finalize,
+// the buyer reference is validated as a Leitweg-ID before the request is sent.
 type CreateInvoiceOptions struct {
 	Finalize                bool
 	PrecedingSalesVoucherID string
@@ -180,9 +250,12 @@ type CreateInvoiceOptions struct {
 // <https://developers.lexoffice.io/docs/?shell#invoices-endpoint-create-an-invoice> and
 // <https://developers.lexoffice.io/docs/?shell#invoices-endpoint-pursue-to-an-invoice>
 func (c *Client) CreateInvoice(ctx context.Context, o CreateInvoiceOptions) (InvoiceResponse, error) {
+	if err := o.Body.XRechnung.Validate(); err != nil {
+		return InvoiceResponse{}, fmt.Errorf("error creating invoice: %w", err)
+	}
+
 	var ir InvoiceResponse
-	var er ErrorResponse
-	qb := c.Request("/v1/invoices").ToJSON(&ir).Post().ErrorJSON(&er)
+	qb := c.Request("/v1/invoices").ToJSON(&ir).Post()
 	if o.Finalize {
 		qb = qb.Param("finalize", "true")
 	}
@@ -195,7 +268,7 @@ func (c *Client) CreateInvoice(ctx context.Context, o CreateInvoiceOptions) (Inv
 
 	err := qb.Fetch(ctx)
 	if err != nil {
-		return ir, fmt.Errorf("error creating invoice (%s): %w", er.String(), err)
+		return ir, fmt.Errorf("error creating invoice: %w", err)
 	}
 
 	return ir, nil
@@ -209,13 +282,49 @@ type RenderResponse struct {
 // <https://developers.lexoffice.io/docs/?shell#invoices-endpoint-render-an-invoice-document-pdf>
 func (c *Client) RenderInvoicePDF(ctx context.Context, invoiceID string) (RenderResponse, error) {
 	var df RenderResponse
-	var er ErrorResponse
-	err := c.Requestf("/v1/invoices/%s/document", invoiceID).ToJSON(&df).ErrorJSON(&er).Fetch(ctx)
+	err := c.Requestf("/v1/invoices/%s/document", invoiceID).ToJSON(&df).Fetch(ctx)
+	if err != nil {
+		return RenderResponse{}, fmt.Errorf("error getting document file id: %w", err)
+	}
+
+	return df, nil
+}
+
+// InvoiceFileFormat is the format of an invoice file, sent as the Accept header.
+type InvoiceFileFormat string
+
+const (
+	// InvoiceFileFormatPDF is a pdf with an embedded ZUGFeRD xml
+	InvoiceFileFormatPDF InvoiceFileFormat = "application/pdf"
+	// InvoiceFileFormatXRechnung is a XRechnung xml, required by public-sector customers
+	InvoiceFileFormatXRechnung InvoiceFileFormat = "application/xml"
+)
+
+// InvoiceFile describes a downloaded invoice file
+type InvoiceFile struct {
+	ContentType string
+	Filename    string
+}
+
+// DownloadInvoiceFile renders an invoice and writes the file to out in a single call
+// <https://developers.lexoffice.io/docs/?shell#invoices-endpoint-download-an-invoice-file>
+func (c *Client) DownloadInvoiceFile(ctx context.Context, out io.Writer, invoiceID string, format InvoiceFileFormat) (InvoiceFile, error) {
+	h := http.Header{}
+	err := c.Requestf("/v1/invoices/%s/file", invoiceID).
+		Header("Accept", string(format)).
+		CopyHeaders(h).
+		ToWriter(out).
+		Fetch(ctx)
 	if err != nil {
-		return RenderResponse{}, fmt.Errorf("error getting document file id (%s): %w", er.String(), err)
+		return InvoiceFile{}, fmt.Errorf("error downloading invoice file: %w", err)
+	}
+
+	f := InvoiceFile{ContentType: h.Get("Content-Type")}
+	if _, params, err := mime.ParseMediaType(h.Get("Content-Disposition")); err == nil {
+		f.Filename = params["filename"]
 	}
 
-	return RenderResponse{}, nil
+	return f, nil
 }
 
 // DeeplinkInvoiceURL is to get the deeplink url for a invoice
@@ -0,0 +1,131 @@
+package golexoffice_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetClosingInvoice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/invoices/e9066f04-8cc7-4616-93f8-ac9ecc8479c8", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		//nolint:errcheck
+		w.Write([]byte(`{
+			"id": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+			"voucherStatus": "open",
+			"voucherNumber": "RE1020",
+			"closingInvoice": true,
+			"downPaymentDeductions": [
+				{
+					"id": "7a1e3e7c-9d63-4a1f-8f16-0a2ebd6d0dcb",
+					"voucherType": "downpaymentinvoice",
+					"title": "1. Abschlagsrechnung",
+					"voucherNumber": "RE1019",
+					"voucherDate": "2023-01-12T00:00:00.000+01:00",
+					"receivedGrossAmount": 119.00,
+					"receivedNetAmount": 100.00,
+					"receivedTaxAmount": 19.00,
+					"taxRatePercentage": 19
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+	resp, err := config.GetInvoice(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8")
+	assert.NoError(t, err)
+	assert.True(t, resp.ClosingInvoice)
+	assert.Len(t, resp.DownPaymentDeductions, 1)
+	assert.Equal(t, "RE1019", resp.DownPaymentDeductions[0].VoucherNumber)
+	assert.True(t, decimal.NewFromInt(119).Equal(resp.DownPaymentDeductions[0].ReceivedGrossAmount))
+}
+
+func TestDownloadInvoiceFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/invoices/e9066f04-8cc7-4616-93f8-ac9ecc8479c8/file", r.URL.Path)
+
+		if r.Header.Get("Accept") == "application/xml" {
+			w.Header().Set("Content-Type", "application/xml")
+			w.Header().Set("Content-Disposition", `attachment; filename="RE1019.xml"`)
+			//nolint:errcheck
+			w.Write([]byte("<Invoice/>"))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", `attachment; filename="RE1019.pdf"`)
+		//nolint:errcheck
+		w.Write([]byte("%PDF-1.4"))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("format=pdf", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		f, err := config.DownloadInvoiceFile(context.Background(), out, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", lexoffice.InvoiceFileFormatPDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "application/pdf", f.ContentType)
+		assert.Equal(t, "RE1019.pdf", f.Filename)
+		assert.Equal(t, "%PDF-1.4", out.String())
+	})
+
+	t.Run("format=xrechnung", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		f, err := config.DownloadInvoiceFile(context.Background(), out, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", lexoffice.InvoiceFileFormatXRechnung)
+		assert.NoError(t, err)
+		assert.Equal(t, "application/xml", f.ContentType)
+		assert.Equal(t, "RE1019.xml", f.Filename)
+		assert.Equal(t, "<Invoice/>", out.String())
+	})
+}
+
+func TestRenderInvoicePDF(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/invoices" {
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "3a2b1c0d-b6dd-11ee-b80a-dbc65f4ceccf", body["printLayoutId"])
+
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{"id": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", "version": 1}`))
+			return
+		}
+
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/invoices/e9066f04-8cc7-4616-93f8-ac9ecc8479c8/document", r.URL.Path)
+		//nolint:errcheck
+		w.Write([]byte(`{"documentFileId": "b26e1d73-19ff-46b1-8929-09d8d73d0f5e"}`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := config.CreateInvoice(context.Background(), lexoffice.CreateInvoiceOptions{
+			Finalize: true,
+			Body:     lexoffice.InvoiceBody{PrintLayoutID: "3a2b1c0d-b6dd-11ee-b80a-dbc65f4ceccf"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", resp.ID)
+	})
+
+	t.Run("render", func(t *testing.T) {
+		resp, err := config.RenderInvoicePDF(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8")
+		assert.NoError(t, err)
+		assert.Equal(t, "b26e1d73-19ff-46b1-8929-09d8d73d0f5e", resp.ID)
+	})
+}
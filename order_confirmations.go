@@ -0,0 +1,90 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// OrderConfirmationBody is to define body data
+type OrderConfirmationBody struct {
+	ID                 string                        `json:"id,omitempty"`
+	OrganizationID     string                        `json:"organizationId,omitempty"`
+	CreatedDate        Date                          `json:"createdDate,omitempty"`
+	UpdatedDate        Date                          `json:"updatedDate,omitempty"`
+	Version            int                           `json:"version,omitempty"`
+	Archived           bool                          `json:"archived,omitempty"`
+	VoucherStatus      VoucherStatus                 `json:"voucherStatus,omitempty"`
+	VoucherNumber      string                        `json:"voucherNumber,omitempty"`
+	VoucherDate        Date                          `json:"voucherDate,omitempty"`
+	Address            InvoiceBodyAddress            `json:"address,omitempty"`
+	LineItems          []InvoiceBodyLineItems        `json:"lineItems,omitempty"`
+	TotalPrice         InvoiceBodyTotalPrice         `json:"totalPrice,omitempty"`
+	TaxAmounts         []InvoiceBodyTaxAmounts       `json:"taxAmounts,omitempty"`
+	TaxConditions      InvoiceBodyTaxConditions      `json:"taxConditions,omitempty"`
+	PaymentConditions  InvoiceBodyPaymentConditions  `json:"paymentConditions,omitempty"`
+	ShippingConditions InvoiceBodyShippingConditions `json:"shippingConditions,omitempty"`
+	Title              string                        `json:"title,omitempty"`
+	Introduction       string                        `json:"introduction,omitempty"`
+	Language           LanguageOption                `json:"language,omitempty"`
+	Remark             string                        `json:"remark,omitempty"`
+	DeliveryTerms      string                        `json:"deliveryTerms,omitempty"`
+	Files              InvoiceBodyFiles              `json:"files,omitempty"`
+	PrintLayoutID      string                        `json:"printLayoutId,omitempty"`
+}
+
+// GetOrderConfirmation is to get an order confirmation
+// <https://developers.lexoffice.io/docs/?shell#order-confirmations-endpoint-retrieve-an-order-confirmation>
+func (c *Client) GetOrderConfirmation(ctx context.Context, id string) (OrderConfirmationBody, error) {
+	var ob OrderConfirmationBody
+	err := c.Requestf("/v1/order-confirmations/%s", id).ToJSON(&ob).Fetch(ctx)
+	if err != nil {
+		return ob, fmt.Errorf("error getting order confirmation: %w", err)
+	}
+
+	return ob, nil
+}
+
+// CreateOrderConfirmationOptions represent the set of possible options when creating an order confirmation.
+// if you provide a body, then the order confirmation will be created with the given body.
+// if you provide a preceding sales voucher id (usually a quotation),
+// then the order confirmation will be pursued from the sales voucher with the given id.
+type CreateOrderConfirmationOptions struct {
+	PrecedingSalesVoucherID string
+	Body                    OrderConfirmationBody
+}
+
+// CreateOrderConfirmation is to create a new order confirmation, or to pursue a sales voucher to an order confirmation
+// <https://developers.lexoffice.io/docs/?shell#order-confirmations-endpoint-create-an-order-confirmation> and
+// <https://developers.lexoffice.io/docs/?shell#order-confirmations-endpoint-pursue-to-an-order-confirmation>
+func (c *Client) CreateOrderConfirmation(ctx context.Context, o CreateOrderConfirmationOptions) (InvoiceResponse, error) {
+	var ir InvoiceResponse
+	qb := c.Request("/v1/order-confirmations").ToJSON(&ir).Post()
+	if o.PrecedingSalesVoucherID != "" {
+		qb = qb.Param("precedingSalesVoucherId", o.PrecedingSalesVoucherID)
+	}
+
+	// an empty body is left out, so a quotation can be pursued by its id alone
+	if !reflect.ValueOf(o.Body).IsZero() {
+		qb = qb.BodyJSON(o.Body)
+	}
+
+	err := qb.Fetch(ctx)
+	if err != nil {
+		return ir, fmt.Errorf("error creating order confirmation: %w", err)
+	}
+
+	return ir, nil
+}
+
+// RenderOrderConfirmationPDF is to render an order confirmation as pdf
+// <https://developers.lexoffice.io/docs/?shell#order-confirmations-endpoint-render-an-order-confirmation-document-pdf>
+func (c *Client) RenderOrderConfirmationPDF(ctx context.Context, orderConfirmationID string) (RenderResponse, error) {
+	var df RenderResponse
+	err := c.Requestf("/v1/order-confirmations/%s/document", orderConfirmationID).ToJSON(&df).Fetch(ctx)
+	if err != nil {
+		return RenderResponse{}, fmt.Errorf("error getting document file id: %w", err)
+	}
+
+	return df, nil
+}
@@ -0,0 +1,112 @@
+package golexoffice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderConfirmations(t *testing.T) {
+	server := orderConfirmationMock(t)
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("pursue", func(t *testing.T) {
+		resp, err := config.CreateOrderConfirmation(context.Background(), lexoffice.CreateOrderConfirmationOptions{
+			PrecedingSalesVoucherID: "424f784e-1f4e-439e-8f71-19673e6d6583",
+			Body: lexoffice.OrderConfirmationBody{
+				Title:         "Auftragsbestätigung",
+				DeliveryTerms: "Lieferung an die angegebene Lieferadresse",
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", resp.ID)
+	})
+
+	t.Run("pursue-without-body", func(t *testing.T) {
+		resp, err := config.CreateOrderConfirmation(context.Background(), lexoffice.CreateOrderConfirmationOptions{
+			PrecedingSalesVoucherID: "b26e1d73-19ff-46b1-8929-09d8d73d0f5e",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", resp.ID)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		resp, err := config.GetOrderConfirmation(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8")
+		assert.NoError(t, err)
+		assert.Equal(t, "AB0001", resp.VoucherNumber)
+		assert.Equal(t, lexoffice.VoucherStatusDraft, resp.VoucherStatus)
+		assert.Equal(t, "Lieferung an die angegebene Lieferadresse", resp.DeliveryTerms)
+	})
+
+	t.Run("render", func(t *testing.T) {
+		resp, err := config.RenderOrderConfirmationPDF(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8")
+		assert.NoError(t, err)
+		assert.Equal(t, "7a1e3e7c-9d63-4a1f-8f16-0a2ebd6d0dcb", resp.ID)
+	})
+}
+
+func orderConfirmationMock(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/order-confirmations" {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+
+			switch r.URL.Query().Get("precedingSalesVoucherId") {
+			case "424f784e-1f4e-439e-8f71-19673e6d6583":
+				var ob map[string]any
+				assert.NoError(t, json.Unmarshal(body, &ob))
+				assert.Equal(t, "Auftragsbestätigung", ob["title"])
+				assert.Equal(t, "Lieferung an die angegebene Lieferadresse", ob["deliveryTerms"])
+			case "b26e1d73-19ff-46b1-8929-09d8d73d0f5e":
+				assert.Empty(t, body)
+			default:
+				t.Errorf("unexpected precedingSalesVoucherId: %s", r.URL.RawQuery)
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+				"resourceUri": "https://api.lexoffice.io/v1/order-confirmations/e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+				"createdDate": "2023-04-24T08:20:22.528+02:00",
+				"updatedDate": "2023-04-24T08:20:22.528+02:00",
+				"version": 1
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/order-confirmations/e9066f04-8cc7-4616-93f8-ac9ecc8479c8" {
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+				"voucherStatus": "draft",
+				"voucherNumber": "AB0001",
+				"voucherDate": "2023-04-24T00:00:00.000+02:00",
+				"title": "Auftragsbestätigung",
+				"deliveryTerms": "Lieferung an die angegebene Lieferadresse"
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/order-confirmations/e9066f04-8cc7-4616-93f8-ac9ecc8479c8/document" {
+			//nolint:errcheck
+			w.Write([]byte(`{"documentFileId": "7a1e3e7c-9d63-4a1f-8f16-0a2ebd6d0dcb"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		//nolint:errcheck
+		w.Write([]byte(fmt.Sprintf(`{"message": "not found (%s): %s"}`, r.Method, r.RequestURI)))
+	}))
+}
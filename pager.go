@@ -0,0 +1,103 @@
+package golexoffice
+
+import "context"
+
+// PagerOptions configures a Pager.
+type PagerOptions struct {
+	// Size is the number of items per page, left to lexoffice when 0.
+	Size int
+	// MaxPages is the maximum number of pages to fetch, unlimited when 0.
+	MaxPages int
+}
+
+// PageFetcher fetches a single page, reporting whether it is the last one.
+type PageFetcher[T any] func(ctx context.Context, page int) (items []T, last bool, err error)
+
+// Pager walks through the items of a paged endpoint, fetching pages lazily.
+//
+//	p := c.ContactsPager(GetContactsParams{}, PagerOptions{})
+//	for p.Next(ctx) {
+//		fmt.Println(p.Item())
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	fetch    PageFetcher[T]
+	page     int
+	maxPages int
+	fetched  int
+
+	items []T
+	i     int
+	last  bool
+	err   error
+}
+
+// NewPager returns a pager starting at page start and fetching at most maxPages pages,
+// or every page when maxPages is 0.
+func NewPager[T any](fetch PageFetcher[T], start, maxPages int) *Pager[T] {
+	return &Pager[T]{
+		fetch:    fetch,
+		page:     start,
+		maxPages: maxPages,
+		i:        -1,
+	}
+}
+
+// Next advances to the next item, fetching the next page when needed.
+// It returns false when there are no more items or an error occurred, see Err.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+
+	p.i++
+	if p.i < len(p.items) {
+		return true
+	}
+
+	if p.last || (p.maxPages > 0 && p.fetched >= p.maxPages) {
+		return false
+	}
+
+	items, last, err := p.fetch(ctx, p.page)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	p.page++
+	p.fetched++
+	p.items = items
+	p.i = 0
+	p.last = last || len(items) == 0
+
+	return len(items) > 0
+}
+
+// Item returns the current item, or the zero value when Next
+// has not been called yet or returned false.
+func (p *Pager[T]) Item() T {
+	if p.i < 0 || p.i >= len(p.items) {
+		var zero T
+		return zero
+	}
+
+	return p.items[p.i]
+}
+
+// Err returns the error that stopped Next, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All collects the remaining items.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for p.Next(ctx) {
+		all = append(all, p.Item())
+	}
+
+	return all, p.Err()
+}
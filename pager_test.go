@@ -0,0 +1,123 @@
+package golexoffice_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContactsPager(t *testing.T) {
+	// serves 5 contacts, page 3 fails when failing is set
+	pagedMock := func(failing bool) *httptest.Server {
+		ids := []string{"a", "b", "c", "d", "e"}
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			assert.Equal(t, "inge@musterfrau.de", q.Get("email"))
+			assert.Equal(t, "Musterfrau", q.Get("name"))
+			assert.Equal(t, "10308", q.Get("number"))
+
+			page, _ := strconv.Atoi(q.Get("page"))
+			size, err := strconv.Atoi(q.Get("size"))
+			if err != nil {
+				size = 25
+			}
+
+			if failing && page == 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			var cr lexoffice.ContactsReturn
+			for i := page * size; i < len(ids) && i < (page+1)*size; i++ {
+				cr.Content = append(cr.Content, lexoffice.ContactsContent{Id: ids[i]})
+			}
+			cr.Number = page
+			cr.Size = size
+			cr.Last = (page+1)*size >= len(ids)
+
+			w.Header().Set("Content-Type", "application/json")
+			//nolint:errcheck
+			json.NewEncoder(w).Encode(cr)
+		}))
+	}
+
+	ids := func(cs []lexoffice.ContactsContent) []string {
+		var ids []string
+		for _, c := range cs {
+			ids = append(ids, c.Id)
+		}
+		return ids
+	}
+
+	params := lexoffice.GetContactsParams{
+		Email:  omit.From("inge@musterfrau.de"),
+		Name:   omit.From("Musterfrau"),
+		Number: omit.From(10308),
+	}
+
+	t.Run("all", func(t *testing.T) {
+		server := pagedMock(false)
+		defer server.Close()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL))
+		all, err := c.ContactsPager(params, lexoffice.PagerOptions{Size: 2}).All(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, ids(all))
+	})
+
+	t.Run("next", func(t *testing.T) {
+		server := pagedMock(false)
+		defer server.Close()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL))
+		p := c.ContactsPager(params, lexoffice.PagerOptions{Size: 3})
+
+		var got []string
+		for p.Next(context.Background()) {
+			got = append(got, p.Item().Id)
+		}
+		assert.NoError(t, p.Err())
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, got)
+	})
+
+	t.Run("item", func(t *testing.T) {
+		server := pagedMock(false)
+		defer server.Close()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL))
+		p := c.ContactsPager(params, lexoffice.PagerOptions{Size: 5})
+		assert.Zero(t, p.Item())
+
+		for p.Next(context.Background()) {
+		}
+		assert.NoError(t, p.Err())
+		assert.Zero(t, p.Item())
+	})
+
+	t.Run("max-pages", func(t *testing.T) {
+		server := pagedMock(false)
+		defer server.Close()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL))
+		all, err := c.ContactsPager(params, lexoffice.PagerOptions{Size: 2, MaxPages: 2}).All(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "d"}, ids(all))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		server := pagedMock(true)
+		defer server.Close()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL))
+		all, err := c.ContactsPager(params, lexoffice.PagerOptions{Size: 2}).All(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "d"}, ids(all))
+	})
+}
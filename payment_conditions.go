@@ -0,0 +1,53 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/opt/omit"
+)
+
+// PaymentCondition is to decode json data
+type PaymentCondition struct {
+	ID                        string                                `json:"id"`
+	OrganizationDefault       bool                                  `json:"organizationDefault"`
+	PaymentTermLabelTemplate  string                                `json:"paymentTermLabelTemplate"`
+	PaymentTermDuration       int                                   `json:"paymentTermDuration"`
+	PaymentDiscountConditions *InvoiceBodyPaymentDiscountConditions `json:"paymentDiscountConditions"`
+}
+
+// InvoicePaymentConditions turns the payment condition into the payment conditions of an invoice.
+// The label template is used as the label, lexoffice fills its placeholders when rendering.
+func (p PaymentCondition) InvoicePaymentConditions() InvoiceBodyPaymentConditions {
+	return InvoiceBodyPaymentConditions{
+		PaymentTermLabel:          p.PaymentTermLabelTemplate,
+		PaymentTermDuration:       p.PaymentTermDuration,
+		PaymentDiscountConditions: omit.FromPtr(p.PaymentDiscountConditions),
+	}
+}
+
+// PaymentConditions is the list of payment conditions of the organization
+type PaymentConditions []PaymentCondition
+
+// Default returns the payment condition marked as the organization default
+func (ps PaymentConditions) Default() (PaymentCondition, bool) {
+	for _, p := range ps {
+		if p.OrganizationDefault {
+			return p, true
+		}
+	}
+
+	return PaymentCondition{}, false
+}
+
+// GetPaymentConditions is to get the payment conditions configured for the organization
+// <https://developers.lexoffice.io/docs/?shell#payment-conditions-endpoint-retrieve-list-of-payment-conditions>
+func (c *Client) GetPaymentConditions(ctx context.Context) (PaymentConditions, error) {
+	var ps PaymentConditions
+	err := c.Request("/v1/payment-conditions").ToJSON(&ps).Fetch(ctx)
+	if err != nil {
+		return ps, fmt.Errorf("error getting payment conditions: %w", err)
+	}
+
+	return ps, nil
+}
@@ -0,0 +1,55 @@
+package golexoffice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentConditions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/payment-conditions", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		//nolint:errcheck
+		w.Write([]byte(`[
+			{
+				"id": "0d6f9d4c-0a38-4b3f-9f20-2b6b2c1c5b46",
+				"organizationDefault": false,
+				"paymentTermLabelTemplate": "Zahlbar sofort, rein netto",
+				"paymentTermDuration": 0
+			},
+			{
+				"id": "ccd6d3ad-9f6d-4a4e-8f1f-8e9b5e2f3b31",
+				"organizationDefault": true,
+				"paymentTermLabelTemplate": "{discountRange} Tage -{discount}, {paymentRange} Tage netto",
+				"paymentTermDuration": 30,
+				"paymentDiscountConditions": {
+					"discountPercentage": 2.5,
+					"discountRange": 10
+				}
+			}
+		]`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+	resp, err := config.GetPaymentConditions(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, resp, 2)
+
+	def, ok := resp.Default()
+	assert.True(t, ok)
+
+	pc := def.InvoicePaymentConditions()
+	assert.Equal(t, 30, pc.PaymentTermDuration)
+	assert.Equal(t, "{discountRange} Tage -{discount}, {paymentRange} Tage netto", pc.PaymentTermLabel)
+	assert.Equal(t, 2.5, pc.PaymentDiscountConditions.MustGet().DiscountPercentage)
+	assert.Equal(t, 10, pc.PaymentDiscountConditions.MustGet().DiscountRange)
+
+	assert.False(t, resp[0].InvoicePaymentConditions().PaymentDiscountConditions.IsSet())
+}
@@ -0,0 +1,47 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// PaymentStatus is the payment status of a voucher.
+type PaymentStatus string
+
+const (
+	PaymentStatusBalanced    PaymentStatus = "balanced"
+	PaymentStatusOpenRevenue PaymentStatus = "openRevenue"
+	PaymentStatusOpenExpense PaymentStatus = "openExpense"
+)
+
+// PaymentsResponse is to decode json data
+type PaymentsResponse struct {
+	OpenAmount    decimal.Decimal        `json:"openAmount"`
+	Currency      string                 `json:"currency"`
+	PaymentStatus PaymentStatus          `json:"paymentStatus"`
+	VoucherType   VoucherType            `json:"voucherType"`
+	VoucherStatus VoucherStatus          `json:"voucherStatus"`
+	PaidDate      *Date                  `json:"paidDate"`
+	PaymentItems  []PaymentsResponseItem `json:"paymentItems"`
+}
+
+type PaymentsResponseItem struct {
+	PaymentItemType string          `json:"paymentItemType"`
+	PostingDate     Date            `json:"postingDate"`
+	Amount          decimal.Decimal `json:"amount"`
+	Currency        string          `json:"currency"`
+}
+
+// GetPayments is to get the payment status of any sales or bookkeeping voucher
+// <https://developers.lexoffice.io/docs/?shell#payments-endpoint-retrieve-payment-information>
+func (c *Client) GetPayments(ctx context.Context, voucherID string) (PaymentsResponse, error) {
+	var pr PaymentsResponse
+	err := c.Requestf("/v1/payments/%s", voucherID).ToJSON(&pr).Fetch(ctx)
+	if err != nil {
+		return pr, fmt.Errorf("error getting payments: %w", err)
+	}
+
+	return pr, nil
+}
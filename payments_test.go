@@ -0,0 +1,101 @@
+package golexoffice_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPayments(t *testing.T) {
+	server := paymentsMock()
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("status=balanced", func(t *testing.T) {
+		resp, err := config.GetPayments(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8")
+		assert.NoError(t, err)
+
+		assert.True(t, decimal.Zero.Equal(resp.OpenAmount))
+		assert.Equal(t, "EUR", resp.Currency)
+		assert.Equal(t, lexoffice.PaymentStatusBalanced, resp.PaymentStatus)
+		assert.Equal(t, lexoffice.VoucherTypeInvoice, resp.VoucherType)
+		assert.Equal(t, lexoffice.VoucherStatusPaid, resp.VoucherStatus)
+		if assert.NotNil(t, resp.PaidDate) {
+			assert.Equal(t, time.Date(2023, 9, 20, 0, 0, 0, 0, time.UTC), time.Time(*resp.PaidDate).UTC())
+		}
+
+		if assert.Len(t, resp.PaymentItems, 2) {
+			assert.Equal(t, "manualPayment", resp.PaymentItems[0].PaymentItemType)
+			assert.True(t, decimal.RequireFromString("119.0").Equal(resp.PaymentItems[0].Amount))
+			assert.True(t, decimal.RequireFromString("-2.38").Equal(resp.PaymentItems[1].Amount))
+			assert.Equal(t, 2023, time.Time(resp.PaymentItems[1].PostingDate).Year())
+		}
+	})
+
+	t.Run("status=openRevenue", func(t *testing.T) {
+		resp, err := config.GetPayments(context.Background(), "c73d5f78-847e-49d8-aa58-c6d95c5c9cb5")
+		assert.NoError(t, err)
+
+		assert.True(t, decimal.RequireFromString("58.31").Equal(resp.OpenAmount))
+		assert.Equal(t, lexoffice.PaymentStatusOpenRevenue, resp.PaymentStatus)
+		assert.Equal(t, lexoffice.VoucherStatusOpen, resp.VoucherStatus)
+		assert.Nil(t, resp.PaidDate)
+		assert.Empty(t, resp.PaymentItems)
+	})
+}
+
+func paymentsMock() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/payments/e9066f04-8cc7-4616-93f8-ac9ecc8479c8":
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"openAmount": 0.00,
+				"currency": "EUR",
+				"paymentStatus": "balanced",
+				"voucherType": "invoice",
+				"voucherStatus": "paid",
+				"paidDate": "2023-09-20T02:00:00.000+02:00",
+				"paymentItems": [
+					{
+						"paymentItemType": "manualPayment",
+						"postingDate": "2023-09-20T02:00:00.000+02:00",
+						"amount": 119.00,
+						"currency": "EUR"
+					},
+					{
+						"paymentItemType": "cashDiscount",
+						"postingDate": "2023-09-20T02:00:00.000+02:00",
+						"amount": -2.38,
+						"currency": "EUR"
+					}
+				]
+			}`))
+		case "/v1/payments/c73d5f78-847e-49d8-aa58-c6d95c5c9cb5":
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"openAmount": 58.31,
+				"currency": "EUR",
+				"paymentStatus": "openRevenue",
+				"voucherType": "invoice",
+				"voucherStatus": "open",
+				"paidDate": null,
+				"paymentItems": []
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			//nolint:errcheck
+			w.Write([]byte(fmt.Sprintf(`{"message": "not found (%s): %s"}`, r.Method, r.RequestURI)))
+		}
+	}))
+}
@@ -0,0 +1,62 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+)
+
+// PostingCategoryType is the type of a posting category.
+type PostingCategoryType string
+
+const (
+	PostingCategoryTypeIncome PostingCategoryType = "income"
+	PostingCategoryTypeOutgo  PostingCategoryType = "outgo"
+)
+
+// PostingCategory is to decode json data
+type PostingCategory struct {
+	ID              string              `json:"id"`
+	Name            string              `json:"name"`
+	Type            PostingCategoryType `json:"type"`
+	ContactRequired bool                `json:"contactRequired"`
+	SplitAllowed    bool                `json:"splitAllowed"`
+	GroupName       string              `json:"groupName"`
+}
+
+// PostingCategories is the list of posting categories
+type PostingCategories []PostingCategory
+
+// ByType returns the posting categories of the given type
+func (ps PostingCategories) ByType(t PostingCategoryType) PostingCategories {
+	var filtered PostingCategories
+	for _, p := range ps {
+		if p.Type == t {
+			filtered = append(filtered, p)
+		}
+	}
+
+	return filtered
+}
+
+// FindByName returns the first posting category with the given name
+func (ps PostingCategories) FindByName(name string) (PostingCategory, bool) {
+	for _, p := range ps {
+		if p.Name == name {
+			return p, true
+		}
+	}
+
+	return PostingCategory{}, false
+}
+
+// GetPostingCategories is to get the list of posting categories for bookkeeping vouchers
+// <https://developers.lexoffice.io/docs/?shell#posting-categories-endpoint-retrieve-list-of-posting-categories>
+func (c *Client) GetPostingCategories(ctx context.Context) (PostingCategories, error) {
+	var ps PostingCategories
+	err := c.Request("/v1/posting-categories").ToJSON(&ps).Fetch(ctx)
+	if err != nil {
+		return ps, fmt.Errorf("error getting posting categories: %w", err)
+	}
+
+	return ps, nil
+}
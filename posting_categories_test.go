@@ -0,0 +1,76 @@
+package golexoffice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPostingCategories(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/posting-categories", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		//nolint:errcheck
+		w.Write([]byte(`[
+			{
+				"id": "8f8664a8-fd86-11e1-a21f-0800200c9a66",
+				"name": "Einnahmen",
+				"type": "income",
+				"contactRequired": false,
+				"splitAllowed": true,
+				"groupName": "Einnahmen"
+			},
+			{
+				"id": "8f8664a0-fd86-11e1-a21f-0800200c9a66",
+				"name": "Warenverkauf",
+				"type": "income",
+				"contactRequired": true,
+				"splitAllowed": true,
+				"groupName": "Einnahmen"
+			},
+			{
+				"id": "16d04a28-8b27-4e73-b36f-8b4c13e0e4d6",
+				"name": "Bürobedarf",
+				"type": "outgo",
+				"contactRequired": false,
+				"splitAllowed": true,
+				"groupName": "Betriebsausgaben"
+			}
+		]`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+	categories, err := config.GetPostingCategories(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, categories, 3)
+
+	t.Run("by-type", func(t *testing.T) {
+		income := categories.ByType(lexoffice.PostingCategoryTypeIncome)
+		if assert.Len(t, income, 2) {
+			assert.Equal(t, "Einnahmen", income[0].Name)
+			assert.Equal(t, "Warenverkauf", income[1].Name)
+		}
+
+		outgo := categories.ByType(lexoffice.PostingCategoryTypeOutgo)
+		if assert.Len(t, outgo, 1) {
+			assert.Equal(t, "Betriebsausgaben", outgo[0].GroupName)
+		}
+	})
+
+	t.Run("find-by-name", func(t *testing.T) {
+		c, ok := categories.FindByName("Warenverkauf")
+		assert.True(t, ok)
+		assert.Equal(t, "8f8664a0-fd86-11e1-a21f-0800200c9a66", c.ID)
+		assert.True(t, c.ContactRequired)
+		assert.True(t, c.SplitAllowed)
+
+		_, ok = categories.FindByName("Reisekosten")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,40 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+)
+
+// PrintLayout is to decode json data
+type PrintLayout struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Default bool   `json:"default"`
+}
+
+// PrintLayouts is the list of print layouts of the organization
+type PrintLayouts []PrintLayout
+
+// FindByName returns the print layout with the given name
+func (ps PrintLayouts) FindByName(name string) (PrintLayout, bool) {
+	for _, p := range ps {
+		if p.Name == name {
+			return p, true
+		}
+	}
+
+	return PrintLayout{}, false
+}
+
+// GetPrintLayouts is to get the print layouts of the organization.
+// Their id can be set as PrintLayoutID on sales vouchers.
+// <https://developers.lexoffice.io/docs/?shell#print-layouts-endpoint-retrieve-list-of-print-layouts>
+func (c *Client) GetPrintLayouts(ctx context.Context) (PrintLayouts, error) {
+	var ps PrintLayouts
+	err := c.Request("/v1/print-layouts").ToJSON(&ps).Fetch(ctx)
+	if err != nil {
+		return ps, fmt.Errorf("error getting print layouts: %w", err)
+	}
+
+	return ps, nil
+}
@@ -0,0 +1,44 @@
+package golexoffice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPrintLayouts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/print-layouts", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		//nolint:errcheck
+		w.Write([]byte(`[
+			{"id": "28c212c4-b6dd-11ee-b80a-dbc65f4ceccf", "name": "Standard", "default": true},
+			{"id": "3a2b1c0d-b6dd-11ee-b80a-dbc65f4ceccf", "name": "Zweitmarke", "default": false}
+		]`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+	layouts, err := config.GetPrintLayouts(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, layouts, 2) {
+		assert.True(t, layouts[0].Default)
+		assert.False(t, layouts[1].Default)
+	}
+
+	t.Run("find-by-name", func(t *testing.T) {
+		l, ok := layouts.FindByName("Zweitmarke")
+		assert.True(t, ok)
+		assert.Equal(t, "3a2b1c0d-b6dd-11ee-b80a-dbc65f4ceccf", l.ID)
+	})
+
+	t.Run("find-by-name=unknown", func(t *testing.T) {
+		_, ok := layouts.FindByName("zweitmarke")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,56 @@
+package golexoffice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Profile is to decode json data
+type Profile struct {
+	OrganizationID     string         `json:"organizationId"`
+	CompanyName        string         `json:"companyName"`
+	Created            ProfileCreated `json:"created"`
+	ConnectionID       string         `json:"connectionId"`
+	Features           []string       `json:"features"`
+	BusinessFeatures   []string       `json:"businessFeatures"`
+	SubscriptionStatus string         `json:"subscriptionStatus"`
+	TaxType            string         `json:"taxType"`
+	SmallBusiness      bool           `json:"smallBusiness"`
+}
+
+type ProfileCreated struct {
+	UserID    string `json:"userId"`
+	UserName  string `json:"userName"`
+	UserEmail string `json:"userEmail"`
+	Date      Date   `json:"date"`
+}
+
+// GetProfile is to get the profile of the organization the token belongs to
+// <https://developers.lexoffice.io/docs/?shell#profile-endpoint>
+func (c *Client) GetProfile(ctx context.Context) (Profile, error) {
+	var p Profile
+	err := c.Request("/v1/profile").ToJSON(&p).Fetch(ctx)
+	if err != nil {
+		return p, fmt.Errorf("error getting profile: %w", err)
+	}
+
+	return p, nil
+}
+
+// Validate checks that the client can reach lexoffice with its token.
+// It is meant to be called once at startup, the returned profile
+// can be used to check the tax settings of the organization.
+// A rejected token returns an error matching ErrUnauthorized,
+// so it can be told apart from lexoffice being unavailable.
+func (c *Client) Validate(ctx context.Context) (Profile, error) {
+	p, err := c.GetProfile(ctx)
+	switch {
+	case err == nil:
+		return p, nil
+	case errors.Is(err, ErrUnauthorized):
+		return p, fmt.Errorf("error validating client, the token was rejected: %w", err)
+	default:
+		return p, fmt.Errorf("error validating client, lexoffice could not be reached: %w", err)
+	}
+}
@@ -0,0 +1,75 @@
+package golexoffice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/profile", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer api-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			//nolint:errcheck
+			w.Write([]byte(`{"message": "Unauthorized"}`))
+			return
+		}
+
+		//nolint:errcheck
+		w.Write([]byte(`{
+			"organizationId": "aa93e8a8-2aa3-470b-b914-caad8a255dd8",
+			"companyName": "Testfirma GmbH",
+			"created": {
+				"userId": "1aea7b1b-9ab2-4b2b-a6ac-6f1b4de8bd67",
+				"userName": "Frau Erika Musterfrau",
+				"userEmail": "erika.musterfrau@testfirma.de",
+				"date": "2017-01-03T13:15:45.000+01:00"
+			},
+			"connectionId": "3dea098b-fc8c-4ead-a7be-5e6dc9ba8d29",
+			"features": ["cashbox"],
+			"businessFeatures": ["INVOICING", "INVOICING_PRO", "BOOKKEEPING"],
+			"subscriptionStatus": "active",
+			"taxType": "net",
+			"smallBusiness": false
+		}`))
+	}))
+	defer server.Close()
+
+	t.Run("get", func(t *testing.T) {
+		config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+		resp, err := config.GetProfile(context.Background())
+		assert.NoError(t, err)
+
+		assert.Equal(t, "aa93e8a8-2aa3-470b-b914-caad8a255dd8", resp.OrganizationID)
+		assert.Equal(t, "Testfirma GmbH", resp.CompanyName)
+		assert.Equal(t, "erika.musterfrau@testfirma.de", resp.Created.UserEmail)
+		assert.Equal(t, 2017, time.Time(resp.Created.Date).Year())
+		assert.Equal(t, "3dea098b-fc8c-4ead-a7be-5e6dc9ba8d29", resp.ConnectionID)
+		assert.Equal(t, []string{"cashbox"}, resp.Features)
+		assert.Contains(t, resp.BusinessFeatures, "BOOKKEEPING")
+		assert.Equal(t, "active", resp.SubscriptionStatus)
+		assert.Equal(t, "net", resp.TaxType)
+		assert.False(t, resp.SmallBusiness)
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+		resp, err := config.Validate(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "net", resp.TaxType)
+	})
+
+	t.Run("validate=unauthorized", func(t *testing.T) {
+		config := lexoffice.NewClient("wrong-key", lexoffice.WithBaseUrl(server.URL))
+		_, err := config.Validate(context.Background())
+		assert.ErrorIs(t, err, lexoffice.ErrUnauthorized)
+	})
+}
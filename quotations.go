@@ -0,0 +1,94 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// QuotationBody is to define body data.
+// VoucherStatus is one of VoucherStatusDraft, VoucherStatusOpen,
+// VoucherStatusAccepted or VoucherStatusRejected.
+type QuotationBody struct {
+	ID                string                       `json:"id,omitempty"`
+	OrganizationID    string                       `json:"organizationId,omitempty"`
+	CreatedDate       Date                         `json:"createdDate,omitempty"`
+	UpdatedDate       Date                         `json:"updatedDate,omitempty"`
+	Version           int                          `json:"version,omitempty"`
+	Archived          bool                         `json:"archived,omitempty"`
+	VoucherStatus     VoucherStatus                `json:"voucherStatus,omitempty"`
+	VoucherNumber     string                       `json:"voucherNumber,omitempty"`
+	VoucherDate       Date                         `json:"voucherDate,omitempty"`
+	ExpirationDate    Date                         `json:"expirationDate,omitempty"`
+	Address           InvoiceBodyAddress           `json:"address,omitempty"`
+	LineItems         []InvoiceBodyLineItems       `json:"lineItems,omitempty"`
+	TotalPrice        InvoiceBodyTotalPrice        `json:"totalPrice,omitempty"`
+	TaxAmounts        []InvoiceBodyTaxAmounts      `json:"taxAmounts,omitempty"`
+	TaxConditions     InvoiceBodyTaxConditions     `json:"taxConditions,omitempty"`
+	PaymentConditions InvoiceBodyPaymentConditions `json:"paymentConditions,omitempty"`
+	Title             string                       `json:"title,omitempty"`
+	Introduction      string                       `json:"introduction,omitempty"`
+	Language          LanguageOption               `json:"language,omitempty"`
+	Remark            string                       `json:"remark,omitempty"`
+	Files             InvoiceBodyFiles             `json:"files,omitempty"`
+	PrintLayoutID     string                       `json:"printLayoutId,omitempty"`
+}
+
+// GetQuotation is to get a quotation
+// <https://developers.lexoffice.io/docs/?shell#quotations-endpoint-retrieve-a-quotation>
+func (c *Client) GetQuotation(ctx context.Context, id string) (QuotationBody, error) {
+	var qb QuotationBody
+	err := c.Requestf("/v1/quotations/%s", id).ToJSON(&qb).Fetch(ctx)
+	if err != nil {
+		return qb, fmt.Errorf("error getting quotation: %w", err)
+	}
+
+	return qb, nil
+}
+
+// CreateQuotationOptions represent the set of possible options when creating a quotation.
+type CreateQuotationOptions struct {
+	Finalize bool
+	Body     QuotationBody
+}
+
+// CreateQuotation is to create a new quotation
+// <https://developers.lexoffice.io/docs/?shell#quotations-endpoint-create-a-quotation>
+func (c *Client) CreateQuotation(ctx context.Context, o CreateQuotationOptions) (InvoiceResponse, error) {
+	var ir InvoiceResponse
+	qb := c.Request("/v1/quotations").BodyJSON(o.Body).ToJSON(&ir).Post()
+	if o.Finalize {
+		qb = qb.Param("finalize", "true")
+	}
+
+	err := qb.Fetch(ctx)
+	if err != nil {
+		return ir, fmt.Errorf("error creating quotation: %w", err)
+	}
+
+	return ir, nil
+}
+
+// RenderQuotationPDF is to render a quotation as pdf
+// <https://developers.lexoffice.io/docs/?shell#quotations-endpoint-render-a-quotation-document-pdf>
+func (c *Client) RenderQuotationPDF(ctx context.Context, quotationID string) (RenderResponse, error) {
+	var df RenderResponse
+	err := c.Requestf("/v1/quotations/%s/document", quotationID).ToJSON(&df).Fetch(ctx)
+	if err != nil {
+		return RenderResponse{}, fmt.Errorf("error getting document file id: %w", err)
+	}
+
+	return df, nil
+}
+
+// DeeplinkQuotationURL is to get the deeplink url for a quotation
+// <https://developers.lexoffice.io/docs/?shell#quotations-endpoint-deeplink-to-a-quotation>
+func (c *Client) DeeplinkQuotationURL(ctx context.Context, quotationID string, edit bool) (string, error) {
+	arg := "view"
+	if edit {
+		arg = "edit"
+	}
+
+	p, _ := url.JoinPath("https://app.lexoffice.de/permalink/quotations", arg, quotationID)
+	return p, nil
+}
@@ -0,0 +1,92 @@
+package golexoffice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotations(t *testing.T) {
+	server := quotationMock(t)
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := config.CreateQuotation(context.Background(), lexoffice.CreateQuotationOptions{
+			Finalize: true,
+			Body: lexoffice.QuotationBody{
+				ExpirationDate: lexoffice.Date(time.Date(2023, 3, 22, 0, 0, 0, 0, time.UTC)),
+				Title:          "Angebot",
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "424f784e-1f4e-439e-8f71-19673e6d6583", resp.ID)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		resp, err := config.GetQuotation(context.Background(), "424f784e-1f4e-439e-8f71-19673e6d6583")
+		assert.NoError(t, err)
+		assert.Equal(t, "AG0006", resp.VoucherNumber)
+		assert.Equal(t, 2023, time.Time(resp.ExpirationDate).Year())
+	})
+
+	t.Run("render", func(t *testing.T) {
+		resp, err := config.RenderQuotationPDF(context.Background(), "424f784e-1f4e-439e-8f71-19673e6d6583")
+		assert.NoError(t, err)
+		assert.Equal(t, "b26e1d73-19ff-46b1-8929-09d8d73d0f5e", resp.ID)
+	})
+}
+
+func quotationMock(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/quotations" {
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "true", r.URL.Query().Get("finalize"))
+			assert.Equal(t, "2023-03-22T00:00:00.000+00:00", body["expirationDate"])
+
+			w.WriteHeader(http.StatusCreated)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "424f784e-1f4e-439e-8f71-19673e6d6583",
+				"resourceUri": "https://api.lexoffice.io/v1/quotations/424f784e-1f4e-439e-8f71-19673e6d6583",
+				"createdDate": "2023-02-21T00:00:00.000+01:00",
+				"updatedDate": "2023-02-21T00:00:00.000+01:00",
+				"version": 1
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/quotations/424f784e-1f4e-439e-8f71-19673e6d6583" {
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "424f784e-1f4e-439e-8f71-19673e6d6583",
+				"voucherStatus": "open",
+				"voucherNumber": "AG0006",
+				"voucherDate": "2023-02-22T00:00:00.000+01:00",
+				"expirationDate": "2023-03-22T00:00:00.000+01:00"
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/quotations/424f784e-1f4e-439e-8f71-19673e6d6583/document" {
+			//nolint:errcheck
+			w.Write([]byte(`{"documentFileId": "b26e1d73-19ff-46b1-8929-09d8d73d0f5e"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		//nolint:errcheck
+		w.Write([]byte(fmt.Sprintf(`{"message": "not found (%s): %s"}`, r.Method, r.RequestURI)))
+	}))
+}
@@ -0,0 +1,141 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/opt/omit"
+)
+
+// ExecutionInterval is the interval in which a recurring template creates invoices.
+type ExecutionInterval string
+
+const (
+	ExecutionIntervalWeekly     ExecutionInterval = "WEEKLY"
+	ExecutionIntervalBiweekly   ExecutionInterval = "BIWEEKLY"
+	ExecutionIntervalMonthly    ExecutionInterval = "MONTHLY"
+	ExecutionIntervalQuarterly  ExecutionInterval = "QUARTERLY"
+	ExecutionIntervalBiannually ExecutionInterval = "BIANNUALLY"
+	ExecutionIntervalAnnually   ExecutionInterval = "ANNUALLY"
+)
+
+// ExecutionStatus is the status of a recurring template.
+type ExecutionStatus string
+
+const (
+	ExecutionStatusActive ExecutionStatus = "ACTIVE"
+	ExecutionStatusPaused ExecutionStatus = "PAUSED"
+	ExecutionStatusEnded  ExecutionStatus = "ENDED"
+)
+
+// RecurringTemplate is to decode json data
+type RecurringTemplate struct {
+	ID                        string                        `json:"id"`
+	OrganizationID            string                        `json:"organizationId"`
+	CreatedDate               Date                          `json:"createdDate"`
+	UpdatedDate               Date                          `json:"updatedDate"`
+	Version                   int                           `json:"version"`
+	Language                  LanguageOption                `json:"language"`
+	Archived                  bool                          `json:"archived"`
+	Address                   InvoiceBodyAddress            `json:"address"`
+	LineItems                 []InvoiceBodyLineItems        `json:"lineItems"`
+	TotalPrice                InvoiceBodyTotalPrice         `json:"totalPrice"`
+	TaxAmounts                []InvoiceBodyTaxAmounts       `json:"taxAmounts"`
+	TaxConditions             InvoiceBodyTaxConditions      `json:"taxConditions"`
+	PaymentConditions         InvoiceBodyPaymentConditions  `json:"paymentConditions"`
+	ShippingConditions        InvoiceBodyShippingConditions `json:"shippingConditions"`
+	Title                     string                        `json:"title"`
+	Introduction              string                        `json:"introduction"`
+	Remark                    string                        `json:"remark"`
+	RecurringTemplateSettings RecurringTemplateSettings     `json:"recurringTemplateSettings"`
+}
+
+// RecurringTemplateSettings are the recurring settings of a template,
+// their dates have no time of day.
+type RecurringTemplateSettings struct {
+	ID                        string            `json:"id"`
+	StartDate                 *DateOnly         `json:"startDate"`
+	EndDate                   *DateOnly         `json:"endDate"`
+	Finalize                  bool              `json:"finalize"`
+	ShippingType              string            `json:"shippingType"`
+	ExecutionInterval         ExecutionInterval `json:"executionInterval"`
+	NextExecutionDate         *DateOnly         `json:"nextExecutionDate"`
+	LastExecutionDate         *DateOnly         `json:"lastExecutionDate"`
+	LastExecutionFailed       bool              `json:"lastExecutionFailed"`
+	LastExecutionErrorMessage string            `json:"lastExecutionErrorMessage"`
+	ExecutionStatus           ExecutionStatus   `json:"executionStatus"`
+}
+
+// RecurringTemplatesReturn is to decode json data
+type RecurringTemplatesReturn struct {
+	Content          []RecurringTemplate  `json:"content"`
+	First            bool                 `json:"first"`
+	Last             bool                 `json:"last"`
+	TotalPages       int                  `json:"totalPages"`
+	TotalElements    int                  `json:"totalElements"`
+	NumberOfElements int                  `json:"numberOfElements"`
+	Size             int                  `json:"size"`
+	Number           int                  `json:"number"`
+	Sort             []ContactsReturnSort `json:"sort"`
+}
+
+type GetRecurringTemplatesParams struct {
+	Page omit.Val[int]
+	Size omit.Val[int]
+
+	// Sort is a property and a direction, e.g. "createdDate,DESC"
+	Sort omit.Val[string]
+}
+
+// GetRecurringTemplates is to get a list of all recurring templates
+// <https://developers.lexoffice.io/docs/?shell#recurring-templates-endpoint-retrieve-all-recurring-templates>
+func (c *Client) GetRecurringTemplates(ctx context.Context, p GetRecurringTemplatesParams) (RecurringTemplatesReturn, error) {
+	var rr RecurringTemplatesReturn
+
+	qb := c.Request("/v1/recurring-templates").
+		ToJSON(&rr)
+
+	if p.Page.IsSet() {
+		qb.ParamInt("page", p.Page.MustGet())
+	}
+
+	if p.Size.IsSet() {
+		qb.ParamInt("size", p.Size.MustGet())
+	}
+
+	if p.Sort.IsSet() {
+		qb.Param("sort", p.Sort.MustGet())
+	}
+
+	err := qb.Fetch(ctx)
+	if err != nil {
+		return RecurringTemplatesReturn{}, fmt.Errorf("error getting recurring templates: %w", err)
+	}
+
+	return rr, nil
+}
+
+// RecurringTemplatesPager returns a pager over all recurring templates, starting at p.Page
+func (c *Client) RecurringTemplatesPager(p GetRecurringTemplatesParams, o PagerOptions) *Pager[RecurringTemplate] {
+	if o.Size > 0 {
+		p.Size = omit.From(o.Size)
+	}
+
+	return NewPager(func(ctx context.Context, page int) ([]RecurringTemplate, bool, error) {
+		p.Page = omit.From(page)
+		rr, err := c.GetRecurringTemplates(ctx, p)
+		return rr.Content, rr.Last, err
+	}, p.Page.GetOrZero(), o.MaxPages)
+}
+
+// GetRecurringTemplate is to get a recurring template by id
+// <https://developers.lexoffice.io/docs/?shell#recurring-templates-endpoint-retrieve-a-recurring-template>
+func (c *Client) GetRecurringTemplate(ctx context.Context, id string) (RecurringTemplate, error) {
+	var rt RecurringTemplate
+	err := c.Requestf("/v1/recurring-templates/%s", id).ToJSON(&rt).Fetch(ctx)
+	if err != nil {
+		return rt, fmt.Errorf("error getting recurring template: %w", err)
+	}
+
+	return rt, nil
+}
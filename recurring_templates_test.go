@@ -0,0 +1,140 @@
+package golexoffice_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+const recurringTemplate = `{
+	"id": "ac1d66a8-6d59-408b-9413-d56b1db7946f",
+	"organizationId": "aa93e8a8-2aa3-470b-b914-caad8a255dd8",
+	"createdDate": "2023-02-10T09:00:00.000+01:00",
+	"updatedDate": "2023-02-10T09:00:00.000+01:00",
+	"version": 0,
+	"language": "de",
+	"archived": false,
+	"address": {
+		"contactId": "97c5794f-8ab2-43ad-b459-c5980b055e4d",
+		"name": "Berliner Kindl GmbH",
+		"street": "Jubiläumsweg 25",
+		"city": "Berlin",
+		"zip": "14089",
+		"countryCode": "DE"
+	},
+	"lineItems": [
+		{
+			"id": "97b98491-e953-4dc9-97a9-ae437a8052b4",
+			"type": "material",
+			"name": "Berliner Kindl Pils",
+			"quantity": 1,
+			"unitName": "Stück",
+			"unitPrice": {"currency": "EUR", "netAmount": 13.4, "grossAmount": 15.95, "taxRatePercentage": 19},
+			"discountPercentage": 0,
+			"lineItemAmount": 13.4
+		}
+	],
+	"totalPrice": {
+		"currency": "EUR",
+		"totalNetAmount": 13.4,
+		"totalGrossAmount": 15.95,
+		"totalTaxAmount": 2.55
+	},
+	"taxAmounts": [
+		{"taxRatePercentage": 19, "taxAmount": 2.55, "amount": 13.4}
+	],
+	"taxConditions": {"taxType": "net"},
+	"paymentConditions": {"paymentTermLabel": "10 Tage - 3 %, 30 Tage netto", "paymentTermDuration": 30},
+	"title": "Rechnung",
+	"introduction": "Ihre bestellten Positionen stellen wir Ihnen hiermit in Rechnung",
+	"remark": "Vielen Dank für Ihren Einkauf",
+	"recurringTemplateSettings": {
+		"id": "9c5b8bde-7d8f-4b1a-9c3b-3d2e4f5a6b7c",
+		"startDate": "2023-03-01",
+		"endDate": "2023-06-30",
+		"finalize": true,
+		"shippingType": "service",
+		"executionInterval": "MONTHLY",
+		"nextExecutionDate": "2023-03-01",
+		"lastExecutionFailed": false,
+		"lastExecutionErrorMessage": null,
+		"executionStatus": "ACTIVE"
+	}
+}`
+
+func TestRecurringTemplates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1/recurring-templates":
+			assert.Equal(t, "createdDate,DESC", r.URL.Query().Get("sort"))
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"content": [` + recurringTemplate + `],
+				"first": true,
+				"last": true,
+				"totalPages": 1,
+				"totalElements": 1,
+				"numberOfElements": 1,
+				"size": 25,
+				"number": 0
+			}`))
+		case "/v1/recurring-templates/ac1d66a8-6d59-408b-9413-d56b1db7946f":
+			//nolint:errcheck
+			w.Write([]byte(recurringTemplate))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			//nolint:errcheck
+			w.Write([]byte(fmt.Sprintf(`{"message": "not found (%s): %s"}`, r.Method, r.RequestURI)))
+		}
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	check := func(t *testing.T, rt lexoffice.RecurringTemplate) {
+		assert.Equal(t, "ac1d66a8-6d59-408b-9413-d56b1db7946f", rt.ID)
+		assert.Equal(t, "Berliner Kindl GmbH", rt.Address.Name)
+		assert.True(t, decimal.RequireFromString("15.95").Equal(rt.TotalPrice.TotalGrossAmount))
+
+		s := rt.RecurringTemplateSettings
+		assert.Equal(t, lexoffice.ExecutionIntervalMonthly, s.ExecutionInterval)
+		assert.Equal(t, lexoffice.ExecutionStatusActive, s.ExecutionStatus)
+		assert.True(t, s.Finalize)
+		if assert.NotNil(t, s.StartDate) {
+			assert.Equal(t, time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC), time.Time(*s.StartDate))
+		}
+		if assert.NotNil(t, s.EndDate) {
+			assert.Equal(t, time.Date(2023, 6, 30, 0, 0, 0, 0, time.UTC), time.Time(*s.EndDate))
+		}
+		if assert.NotNil(t, s.NextExecutionDate) {
+			assert.Equal(t, time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC), time.Time(*s.NextExecutionDate))
+		}
+		assert.Nil(t, s.LastExecutionDate)
+	}
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := config.GetRecurringTemplates(context.Background(), lexoffice.GetRecurringTemplatesParams{
+			Sort: omit.From("createdDate,DESC"),
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, resp.Content, 1) {
+			check(t, resp.Content[0])
+		}
+	})
+
+	t.Run("get", func(t *testing.T) {
+		resp, err := config.GetRecurringTemplate(context.Background(), "ac1d66a8-6d59-408b-9413-d56b1db7946f")
+		assert.NoError(t, err)
+		check(t, resp)
+	})
+}
@@ -0,0 +1,142 @@
+package golexoffice
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how failed requests are retried.
+// Requests are retried on 429, 502, 503 and 504 responses,
+// with a jittered exponential backoff, or after the Retry-After header when it is set.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+	// MaxElapsed is the total time budget for all attempts of a request.
+	MaxElapsed time.Duration
+	// BaseDelay is the delay before the first retry, doubled on every retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts.
+	MaxDelay time.Duration
+	// RetryPost allows retrying POST requests, which are not idempotent.
+	RetryPost bool
+}
+
+// DefaultRetryPolicy is used for the fields left empty in WithRetry
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MaxElapsed:  30 * time.Second,
+	BaseDelay:   250 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+}
+
+type retryTransport struct {
+	policy RetryPolicy
+	base   http.RoundTripper
+}
+
+func (t retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.retryable(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	start := time.Now()
+	r := req
+	for attempt := 1; ; attempt++ {
+		// a RoundTripper must not modify the request, retries go out on a clone
+		if attempt > 1 {
+			r = req.Clone(req.Context())
+			if req.Body != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				r.Body = body
+			}
+		}
+
+		res, err := t.base.RoundTrip(r)
+		if err != nil || !retryStatus(res.StatusCode) || attempt >= t.policy.MaxAttempts {
+			return res, err
+		}
+
+		delay := t.delay(attempt, res)
+		if time.Since(start)+delay > t.policy.MaxElapsed {
+			return res, nil
+		}
+
+		// drain the body so the connection can be reused
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t retryTransport) retryable(req *http.Request) bool {
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return t.policy.RetryPost
+	default:
+		return false
+	}
+}
+
+// delay returns how long to wait before the next attempt
+func (t retryTransport) delay(attempt int, res *http.Response) time.Duration {
+	if d, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+		return d
+	}
+
+	d := t.policy.BaseDelay << (attempt - 1)
+	if d <= 0 || d > t.policy.MaxDelay {
+		d = t.policy.MaxDelay
+	}
+
+	// jitter between half and the full delay
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+func retryStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses a Retry-After header, in seconds or as an http date
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
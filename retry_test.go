@@ -0,0 +1,123 @@
+package golexoffice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry(t *testing.T) {
+	// fails the first n requests with the given status
+	failing := func(n int32, status int) (*httptest.Server, *atomic.Int32) {
+		calls := &atomic.Int32{}
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if calls.Add(1) <= n {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(status)
+				return
+			}
+
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusCreated)
+			}
+			//nolint:errcheck
+			w.Write([]byte(`{"id": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8"}`))
+		})), calls
+	}
+
+	t.Run("status=429", func(t *testing.T) {
+		server, calls := failing(2, http.StatusTooManyRequests)
+		defer server.Close()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL), lexoffice.WithRetry(lexoffice.RetryPolicy{}))
+		_, err := c.GetContact(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8")
+		assert.NoError(t, err)
+		assert.EqualValues(t, 3, calls.Load())
+	})
+
+	t.Run("max-attempts", func(t *testing.T) {
+		server, calls := failing(10, http.StatusServiceUnavailable)
+		defer server.Close()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL), lexoffice.WithRetry(lexoffice.RetryPolicy{MaxAttempts: 2}))
+		_, err := c.GetContact(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8")
+		assert.Error(t, err)
+		assert.EqualValues(t, 2, calls.Load())
+	})
+
+	t.Run("post", func(t *testing.T) {
+		server, calls := failing(1, http.StatusTooManyRequests)
+		defer server.Close()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL), lexoffice.WithRetry(lexoffice.RetryPolicy{}))
+		_, err := c.CreateContact(context.Background(), lexoffice.ContactBody{})
+		assert.Error(t, err)
+		assert.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("post-opt-in", func(t *testing.T) {
+		server, calls := failing(1, http.StatusTooManyRequests)
+		defer server.Close()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL), lexoffice.WithRetry(lexoffice.RetryPolicy{RetryPost: true}))
+		_, err := c.CreateContact(context.Background(), lexoffice.ContactBody{})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, calls.Load())
+	})
+
+	t.Run("context", func(t *testing.T) {
+		calls := &atomic.Int32{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.Header().Set("Retry-After", "10")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL), lexoffice.WithRetry(lexoffice.RetryPolicy{MaxElapsed: time.Minute}))
+		start := time.Now()
+		_, err := c.GetContact(ctx, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 5*time.Second)
+		assert.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("backoff", func(t *testing.T) {
+		calls := &atomic.Int32{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		policy := lexoffice.RetryPolicy{
+			MaxAttempts: 100,
+			MaxElapsed:  300 * time.Millisecond,
+			BaseDelay:   50 * time.Millisecond,
+			MaxDelay:    100 * time.Millisecond,
+		}
+
+		c := lexoffice.NewClient("api-key", lexoffice.WithClient(&http.Client{}), lexoffice.WithBaseUrl(server.URL), lexoffice.WithRetry(policy))
+		start := time.Now()
+		_, err := c.GetContact(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8")
+		elapsed := time.Since(start)
+
+		var apiErr *lexoffice.APIError
+		if assert.ErrorAs(t, err, &apiErr) {
+			assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+		}
+		assert.Greater(t, calls.Load(), int32(2))
+		assert.Less(t, calls.Load(), int32(policy.MaxAttempts))
+		assert.Less(t, elapsed, policy.MaxElapsed+100*time.Millisecond)
+	})
+}
@@ -0,0 +1,32 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+)
+
+// TransactionAssignmentHint is to define body data
+type TransactionAssignmentHint struct {
+	VoucherID         string `json:"voucherId"`
+	ExternalReference string `json:"externalReference"`
+}
+
+// CreateTransactionAssignmentHint tells lexoffice which voucher a bank transaction belongs to,
+// externalReference being the reference of the transaction in the bank import.
+// <https://developers.lexoffice.io/docs/?shell#transaction-assignment-hint-endpoint-create-a-transaction-assignment-hint>
+func (c *Client) CreateTransactionAssignmentHint(ctx context.Context, voucherID, externalReference string) (TransactionAssignmentHint, error) {
+	var th TransactionAssignmentHint
+	err := c.Request("/v1/transaction-assignment-hints").
+		BodyJSON(TransactionAssignmentHint{
+			VoucherID:         voucherID,
+			ExternalReference: externalReference,
+		}).
+		ToJSON(&th).
+		Post().
+		Fetch(ctx)
+	if err != nil {
+		return th, fmt.Errorf("error creating transaction assignment hint: %w", err)
+	}
+
+	return th, nil
+}
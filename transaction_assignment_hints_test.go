@@ -0,0 +1,74 @@
+package golexoffice_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTransactionAssignmentHint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/transaction-assignment-hints", r.URL.Path)
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		w.Header().Set("Content-Type", "application/json")
+		if body["voucherId"] == "" {
+			w.WriteHeader(http.StatusNotAcceptable)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"timestamp": "2023-05-11T17:12:31.233+02:00",
+				"status": 406,
+				"error": "Not Acceptable",
+				"path": "/v1/transaction-assignment-hints",
+				"traceId": "90d78d0777be",
+				"message": "Validation failed for request. Please see details list for specific causes.",
+				"details": [
+					{"violation": "NOTNULL", "field": "voucherId", "message": "darf nicht leer sein"}
+				]
+			}`))
+			return
+		}
+
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", body["voucherId"])
+		assert.Equal(t, "RE-2023-0042", body["externalReference"])
+
+		//nolint:errcheck
+		w.Write([]byte(`{
+			"voucherId": "e9066f04-8cc7-4616-93f8-ac9ecc8479c8",
+			"externalReference": "RE-2023-0042"
+		}`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := config.CreateTransactionAssignmentHint(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", "RE-2023-0042")
+		assert.NoError(t, err)
+		assert.Equal(t, "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", resp.VoucherID)
+		assert.Equal(t, "RE-2023-0042", resp.ExternalReference)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := config.CreateTransactionAssignmentHint(context.Background(), "", "RE-2023-0042")
+		assert.ErrorIs(t, err, lexoffice.ErrValidation)
+
+		var apiErr *lexoffice.APIError
+		if assert.True(t, errors.As(err, &apiErr)) {
+			assert.Equal(t, http.StatusNotAcceptable, apiErr.StatusCode)
+			assert.Equal(t, "90d78d0777be", apiErr.RequestID)
+			if assert.Len(t, apiErr.Issues, 1) {
+				assert.Equal(t, "voucherId", apiErr.Issues[0].Field)
+			}
+		}
+	})
+}
@@ -0,0 +1,157 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/shopspring/decimal"
+)
+
+// VoucherlistDateFormat is the format of the date filters of the voucherlist
+const VoucherlistDateFormat = "2006-01-02"
+
+// VoucherlistReturn is to decode json data
+type VoucherlistReturn struct {
+	Content          []VoucherlistContent `json:"content"`
+	First            bool                 `json:"first"`
+	Last             bool                 `json:"last"`
+	TotalPages       int                  `json:"totalPages"`
+	TotalElements    int                  `json:"totalElements"`
+	NumberOfElements int                  `json:"numberOfElements"`
+	Size             int                  `json:"size"`
+	Number           int                  `json:"number"`
+	Sort             []ContactsReturnSort `json:"sort"`
+}
+
+type VoucherlistContent struct {
+	ID            string          `json:"id"`
+	VoucherType   VoucherType     `json:"voucherType"`
+	VoucherStatus VoucherStatus   `json:"voucherStatus"`
+	VoucherNumber string          `json:"voucherNumber"`
+	VoucherDate   Date            `json:"voucherDate"`
+	CreatedDate   Date            `json:"createdDate"`
+	UpdatedDate   Date            `json:"updatedDate"`
+	DueDate       *Date           `json:"dueDate"`
+	ContactID     string          `json:"contactId"`
+	ContactName   string          `json:"contactName"`
+	TotalAmount   decimal.Decimal `json:"totalAmount"`
+	OpenAmount    decimal.Decimal `json:"openAmount"`
+	Currency      string          `json:"currency"`
+	Archived      bool            `json:"archived"`
+}
+
+type GetVoucherlistParams struct {
+	// VoucherTypes and VoucherStatuses are required by lexoffice,
+	// at least one of each must be given.
+	VoucherTypes    []VoucherType
+	VoucherStatuses []VoucherStatus
+
+	Archived      omit.Val[bool]
+	ContactID     omit.Val[string]
+	VoucherNumber omit.Val[string]
+
+	VoucherDateFrom omit.Val[time.Time]
+	VoucherDateTo   omit.Val[time.Time]
+	CreatedDateFrom omit.Val[time.Time]
+	CreatedDateTo   omit.Val[time.Time]
+	UpdatedDateFrom omit.Val[time.Time]
+	UpdatedDateTo   omit.Val[time.Time]
+
+	Page omit.Val[int]
+	Size omit.Val[int]
+
+	// Sort is a property and a direction, e.g. "voucherNumber,DESC"
+	Sort omit.Val[string]
+}
+
+// GetVoucherlist is to get a filtered list of vouchers
+// <https://developers.lexoffice.io/docs/?shell#voucherlist-endpoint>
+func (c *Client) GetVoucherlist(ctx context.Context, p GetVoucherlistParams) (VoucherlistReturn, error) {
+	var vr VoucherlistReturn
+
+	voucherTypes := make([]string, len(p.VoucherTypes))
+	for i, t := range p.VoucherTypes {
+		voucherTypes[i] = string(t)
+	}
+
+	voucherStatuses := make([]string, len(p.VoucherStatuses))
+	for i, s := range p.VoucherStatuses {
+		voucherStatuses[i] = string(s)
+	}
+
+	qb := c.Request("/v1/voucherlist").
+		Param("voucherType", strings.Join(voucherTypes, ",")).
+		Param("voucherStatus", strings.Join(voucherStatuses, ",")).
+		ToJSON(&vr)
+
+	if p.Archived.IsSet() {
+		qb.Param("archived", strconv.FormatBool(p.Archived.MustGet()))
+	}
+
+	if p.ContactID.IsSet() {
+		qb.Param("contactId", p.ContactID.MustGet())
+	}
+
+	if p.VoucherNumber.IsSet() {
+		qb.Param("voucherNumber", p.VoucherNumber.MustGet())
+	}
+
+	dates := []struct {
+		key string
+		val omit.Val[time.Time]
+	}{
+		{"voucherDateFrom", p.VoucherDateFrom},
+		{"voucherDateTo", p.VoucherDateTo},
+		{"createdDateFrom", p.CreatedDateFrom},
+		{"createdDateTo", p.CreatedDateTo},
+		{"updatedDateFrom", p.UpdatedDateFrom},
+		{"updatedDateTo", p.UpdatedDateTo},
+	}
+	for _, d := range dates {
+		if d.val.IsSet() {
+			qb.Param(d.key, d.val.MustGet().Format(VoucherlistDateFormat))
+		}
+	}
+
+	if p.Page.IsSet() {
+		qb.ParamInt("page", p.Page.MustGet())
+	}
+
+	if p.Size.IsSet() {
+		qb.ParamInt("size", p.Size.MustGet())
+	}
+
+	if p.Sort.IsSet() {
+		qb.Param("sort", p.Sort.MustGet())
+	}
+
+	err := qb.Fetch(ctx)
+	if err != nil {
+		return VoucherlistReturn{}, fmt.Errorf("error getting voucherlist: %w", err)
+	}
+
+	return vr, nil
+}
+
+// VoucherlistPager returns a pager over all vouchers matching p, starting at p.Page
+func (c *Client) VoucherlistPager(p GetVoucherlistParams, o PagerOptions) *Pager[VoucherlistContent] {
+	if o.Size > 0 {
+		p.Size = omit.From(o.Size)
+	}
+
+	return NewPager(func(ctx context.Context, page int) ([]VoucherlistContent, bool, error) {
+		p.Page = omit.From(page)
+		vr, err := c.GetVoucherlist(ctx, p)
+		return vr.Content, vr.Last, err
+	}, p.Page.GetOrZero(), o.MaxPages)
+}
+
+// GetVoucherlistAll is to get every voucher matching the filters,
+// fetching the pages one after the other starting at p.Page.
+func (c *Client) GetVoucherlistAll(ctx context.Context, p GetVoucherlistParams) ([]VoucherlistContent, error) {
+	return c.VoucherlistPager(p, PagerOptions{}).All(ctx)
+}
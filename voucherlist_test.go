@@ -0,0 +1,70 @@
+package golexoffice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetVoucherlist(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "/v1/voucherlist", r.URL.Path)
+		assert.Equal(t, "invoice,creditnote", q.Get("voucherType"))
+		assert.Equal(t, "open,overdue", q.Get("voucherStatus"))
+		assert.Equal(t, "false", q.Get("archived"))
+		assert.Equal(t, "2023-01-01", q.Get("voucherDateFrom"))
+		assert.Equal(t, "voucherNumber,DESC", q.Get("sort"))
+		assert.False(t, q.Has("contactId"))
+
+		w.Header().Set("Content-Type", "application/json")
+		//nolint:errcheck
+		w.Write([]byte(`{
+			"content": [
+				{
+					"id": "9c5b8c97-3c5c-4a58-8da1-3b5a5b5e5c42",
+					"voucherType": "invoice",
+					"voucherStatus": "open",
+					"voucherNumber": "RE1019",
+					"voucherDate": "2023-02-22T00:00:00.000+01:00",
+					"createdDate": "2023-02-22T10:00:00.000+01:00",
+					"updatedDate": "2023-02-22T10:00:00.000+01:00",
+					"dueDate": "2023-03-01T00:00:00.000+01:00",
+					"contactName": "Beispiel GmbH",
+					"totalAmount": 119.00,
+					"openAmount": 119.00,
+					"currency": "EUR",
+					"archived": false
+				}
+			],
+			"first": true,
+			"last": true,
+			"totalPages": 1,
+			"totalElements": 1,
+			"numberOfElements": 1,
+			"size": 25,
+			"number": 0
+		}`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+	resp, err := config.GetVoucherlist(context.Background(), lexoffice.GetVoucherlistParams{
+		VoucherTypes:    []lexoffice.VoucherType{lexoffice.VoucherTypeInvoice, lexoffice.VoucherTypeCreditNote},
+		VoucherStatuses: []lexoffice.VoucherStatus{lexoffice.VoucherStatusOpen, lexoffice.VoucherStatusOverdue},
+		Archived:        omit.From(false),
+		VoucherDateFrom: omit.From(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Sort:            omit.From("voucherNumber,DESC"),
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Last)
+	assert.Len(t, resp.Content, 1)
+	assert.Equal(t, lexoffice.VoucherTypeInvoice, resp.Content[0].VoucherType)
+	assert.Equal(t, "RE1019", resp.Content[0].VoucherNumber)
+}
@@ -0,0 +1,132 @@
+package golexoffice
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/shopspring/decimal"
+)
+
+// VoucherType is the type of a voucher.
+type VoucherType string
+
+const (
+	VoucherTypeInvoice            VoucherType = "invoice"
+	VoucherTypeDownPaymentInvoice VoucherType = "downpaymentinvoice"
+	VoucherTypeCreditNote         VoucherType = "creditnote"
+	VoucherTypeOrderConfirmation  VoucherType = "orderconfirmation"
+	VoucherTypeQuotation          VoucherType = "quotation"
+	VoucherTypeDeliveryNote       VoucherType = "deliverynote"
+
+	// bookkeeping voucher types
+	VoucherTypeSalesInvoice       VoucherType = "salesinvoice"
+	VoucherTypeSalesCreditNote    VoucherType = "salescreditnote"
+	VoucherTypePurchaseInvoice    VoucherType = "purchaseinvoice"
+	VoucherTypePurchaseCreditNote VoucherType = "purchasecreditnote"
+)
+
+// VoucherTaxType defines whether the amounts of a bookkeeping voucher are net or gross.
+type VoucherTaxType string
+
+const (
+	VoucherTaxTypeNet   VoucherTaxType = "net"
+	VoucherTaxTypeGross VoucherTaxType = "gross"
+)
+
+// VoucherBody is to define body data of a bookkeeping voucher.
+// VoucherStatus is one of VoucherStatusOpen, VoucherStatusPaid, VoucherStatusPaidOff,
+// VoucherStatusVoided, VoucherStatusTransferred, VoucherStatusSepaDebit or VoucherStatusUnchecked.
+type VoucherBody struct {
+	ID                   string             `json:"id,omitempty"`
+	OrganizationID       string             `json:"organizationId,omitempty"`
+	Type                 VoucherType        `json:"type,omitempty"`
+	VoucherStatus        VoucherStatus      `json:"voucherStatus,omitempty"`
+	VoucherNumber        string             `json:"voucherNumber,omitempty"`
+	VoucherDate          Date               `json:"voucherDate,omitempty"`
+	ShippingDate         *Date              `json:"shippingDate,omitempty"`
+	DueDate              *Date              `json:"dueDate,omitempty"`
+	TotalGrossAmount     decimal.Decimal    `json:"totalGrossAmount"`
+	TotalTaxAmount       decimal.Decimal    `json:"totalTaxAmount"`
+	TaxType              VoucherTaxType     `json:"taxType,omitempty"`
+	UseCollectiveContact bool               `json:"useCollectiveContact"`
+	ContactID            string             `json:"contactId,omitempty"`
+	Remark               string             `json:"remark,omitempty"`
+	VoucherItems         []VoucherBodyItems `json:"voucherItems"`
+	Files                []string           `json:"files,omitempty"`
+	CreatedDate          *Date              `json:"createdDate,omitempty"`
+	UpdatedDate          *Date              `json:"updatedDate,omitempty"`
+	Version              int                `json:"version"`
+}
+
+type VoucherBodyItems struct {
+	Amount         decimal.Decimal `json:"amount"`
+	TaxAmount      decimal.Decimal `json:"taxAmount"`
+	TaxRatePercent float64         `json:"taxRatePercent"`
+	CategoryID     string          `json:"categoryId"`
+}
+
+// GetVoucher is to get a bookkeeping voucher
+// <https://developers.lexoffice.io/docs/?shell#vouchers-endpoint-retrieve-a-voucher>
+func (c *Client) GetVoucher(ctx context.Context, id string) (VoucherBody, error) {
+	var vb VoucherBody
+	err := c.Requestf("/v1/vouchers/%s", id).ToJSON(&vb).Fetch(ctx)
+	if err != nil {
+		return vb, fmt.Errorf("error getting voucher: %w", err)
+	}
+
+	return vb, nil
+}
+
+// CreateVoucher is to create a new bookkeeping voucher
+// <https://developers.lexoffice.io/docs/?shell#vouchers-endpoint-create-a-voucher>
+func (c *Client) CreateVoucher(ctx context.Context, body VoucherBody) (InvoiceResponse, error) {
+	var ir InvoiceResponse
+	err := c.Request("/v1/vouchers").
+		BodyJSON(body).
+		ToJSON(&ir).
+		Post().
+		Fetch(ctx)
+	if err != nil {
+		return ir, fmt.Errorf("error creating voucher: %w", err)
+	}
+
+	return ir, nil
+}
+
+// UpdateVoucher updates an existing bookkeeping voucher
+// <https://developers.lexoffice.io/docs/?shell#vouchers-endpoint-update-a-voucher>
+func (c *Client) UpdateVoucher(ctx context.Context, body VoucherBody) (InvoiceResponse, error) {
+	var ir InvoiceResponse
+	err := c.Requestf("/v1/vouchers/%s", body.ID).
+		BodyJSON(body).
+		ToJSON(&ir).
+		Put().
+		Fetch(ctx)
+	if err != nil {
+		return ir, fmt.Errorf("error updating voucher: %w", err)
+	}
+
+	return ir, nil
+}
+
+// AttachVoucherFile uploads a file and attaches it to an existing bookkeeping voucher
+// <https://developers.lexoffice.io/docs/?shell#vouchers-endpoint-upload-a-file-to-a-voucher>
+func (c *Client) AttachVoucherFile(ctx context.Context, voucherID string, r io.Reader, name string) (CreateFileResponse, error) {
+	body, contentType, err := voucherFileForm(r, name)
+	if err != nil {
+		return CreateFileResponse{}, err
+	}
+
+	var fr CreateFileResponse
+	err = c.Requestf("/v1/vouchers/%s/files", voucherID).
+		ContentType(contentType).
+		BodyBytes(body).
+		ToJSON(&fr).
+		Fetch(ctx)
+	if err != nil {
+		return fr, fmt.Errorf("error attaching voucher file: %w", err)
+	}
+
+	return fr, nil
+}
@@ -0,0 +1,170 @@
+package golexoffice_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachVoucherFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/vouchers/e9066f04-8cc7-4616-93f8-ac9ecc8479c8/files", r.URL.Path)
+
+		f, h, err := r.FormFile("file")
+		assert.NoError(t, err)
+		defer f.Close()
+
+		content, err := io.ReadAll(f)
+		assert.NoError(t, err)
+		assert.Equal(t, "receipt.pdf", h.Filename)
+		assert.Equal(t, "%PDF-1.4", string(content))
+		assert.Equal(t, "voucher", r.FormValue("type"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		//nolint:errcheck
+		w.Write([]byte(`{"id": "8118c402-1234-4a1c-b7f8-3a32f6c5f0a4"}`))
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+	resp, err := config.AttachVoucherFile(context.Background(), "e9066f04-8cc7-4616-93f8-ac9ecc8479c8", strings.NewReader("%PDF-1.4"), "receipt.pdf")
+	assert.NoError(t, err)
+	assert.Equal(t, "8118c402-1234-4a1c-b7f8-3a32f6c5f0a4", resp.ID)
+}
+
+func TestVouchers(t *testing.T) {
+	server := voucherMock(t)
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := config.CreateVoucher(context.Background(), lexoffice.VoucherBody{
+			Type:             lexoffice.VoucherTypeSalesInvoice,
+			VoucherNumber:    "RE-00020",
+			VoucherDate:      lexoffice.Date(time.Date(2023, 6, 28, 0, 0, 0, 0, time.UTC)),
+			TotalGrossAmount: decimal.NewFromInt(119),
+			TotalTaxAmount:   decimal.NewFromInt(19),
+			TaxType:          lexoffice.VoucherTaxTypeGross,
+			VoucherItems: []lexoffice.VoucherBodyItems{{
+				Amount:         decimal.NewFromInt(119),
+				TaxAmount:      decimal.NewFromInt(19),
+				TaxRatePercent: 19,
+				CategoryID:     "8f8664a8-fd86-11e1-a21f-0800200c9a66",
+			}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "66196c43-bfee-baf3-4335-d2d8a4efde4c", resp.ID)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		resp, err := config.GetVoucher(context.Background(), "66196c43-bfee-baf3-4335-d2d8a4efde4c")
+		assert.NoError(t, err)
+		assert.Equal(t, "RE-00020", resp.VoucherNumber)
+		assert.Nil(t, resp.ShippingDate)
+		assert.Nil(t, resp.DueDate)
+		if assert.NotNil(t, resp.CreatedDate) {
+			assert.Equal(t, 2023, time.Time(*resp.CreatedDate).Year())
+		}
+		assert.True(t, decimal.NewFromInt(119).Equal(resp.TotalGrossAmount))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		due := lexoffice.Date(time.Date(2023, 7, 28, 0, 0, 0, 0, time.UTC))
+		resp, err := config.UpdateVoucher(context.Background(), lexoffice.VoucherBody{
+			ID:            "66196c43-bfee-baf3-4335-d2d8a4efde4c",
+			Type:          lexoffice.VoucherTypeSalesInvoice,
+			VoucherNumber: "RE-00020",
+			VoucherDate:   lexoffice.Date(time.Date(2023, 6, 28, 0, 0, 0, 0, time.UTC)),
+			DueDate:       &due,
+			Version:       1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, resp.Version)
+	})
+}
+
+func voucherMock(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/vouchers" {
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "2023-06-28T00:00:00.000+00:00", body["voucherDate"])
+			assert.NotContains(t, body, "shippingDate")
+			assert.NotContains(t, body, "dueDate")
+			assert.NotContains(t, body, "createdDate")
+			assert.NotContains(t, body, "updatedDate")
+
+			w.WriteHeader(http.StatusOK)
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "66196c43-bfee-baf3-4335-d2d8a4efde4c",
+				"resourceUri": "https://api.lexoffice.io/v1/vouchers/66196c43-bfee-baf3-4335-d2d8a4efde4c",
+				"createdDate": "2023-06-29T15:15:09.447+02:00",
+				"updatedDate": "2023-06-29T15:15:09.447+02:00",
+				"version": 1
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/vouchers/66196c43-bfee-baf3-4335-d2d8a4efde4c" {
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "66196c43-bfee-baf3-4335-d2d8a4efde4c",
+				"organizationId": "aa93e8a8-2aa3-470b-b914-caad8a255dd8",
+				"type": "salesinvoice",
+				"voucherStatus": "unchecked",
+				"voucherNumber": "RE-00020",
+				"voucherDate": "2023-06-28T00:00:00.000+02:00",
+				"shippingDate": null,
+				"dueDate": null,
+				"totalGrossAmount": 119.00,
+				"totalTaxAmount": 19.00,
+				"taxType": "gross",
+				"useCollectiveContact": true,
+				"voucherItems": [],
+				"files": [],
+				"createdDate": "2023-06-29T15:15:09.447+02:00",
+				"updatedDate": "2023-06-29T15:15:09.447+02:00",
+				"version": 1
+			}`))
+			return
+		}
+
+		if r.Method == http.MethodPut && r.URL.Path == "/v1/vouchers/66196c43-bfee-baf3-4335-d2d8a4efde4c" {
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "2023-07-28T00:00:00.000+00:00", body["dueDate"])
+			assert.NotContains(t, body, "shippingDate")
+			assert.EqualValues(t, 1, body["version"])
+
+			//nolint:errcheck
+			w.Write([]byte(`{
+				"id": "66196c43-bfee-baf3-4335-d2d8a4efde4c",
+				"resourceUri": "https://api.lexoffice.io/v1/vouchers/66196c43-bfee-baf3-4335-d2d8a4efde4c",
+				"createdDate": "2023-06-29T15:15:09.447+02:00",
+				"updatedDate": "2023-06-30T10:00:00.000+02:00",
+				"version": 2
+			}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		//nolint:errcheck
+		w.Write([]byte(fmt.Sprintf(`{"message": "not found (%s): %s"}`, r.Method, r.RequestURI)))
+	}))
+}
@@ -0,0 +1,91 @@
+package golexoffice
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidLeitwegID is returned when a buyer reference is not a valid Leitweg-ID
+var ErrInvalidLeitwegID = errors.New("invalid leitweg-id")
+
+// XRechnung holds the fields required to invoice german public authorities
+type XRechnung struct {
+	// BuyerReference is the Leitweg-ID of the authority
+	BuyerReference         string `json:"buyerReference,omitempty"`
+	VendorNumberAtCustomer string `json:"vendorNumberAtCustomer,omitempty"`
+}
+
+// Validate checks the buyer reference of x, if it is set.
+func (x *XRechnung) Validate() error {
+	if x == nil || x.BuyerReference == "" {
+		return nil
+	}
+
+	return ValidateLeitwegID(x.BuyerReference)
+}
+
+// ValidateLeitwegID checks the format and the check digits of a Leitweg-ID.
+// A Leitweg-ID is made of a coarse address of 2 to 12 digits,
+// an optional fine address of up to 30 alphanumeric characters
+// and 2 check digits computed with ISO 7064 MOD 97-10, separated by dashes.
+// e.g. "04011000-1234512345-06" or "991-33333TEST-33"
+func ValidateLeitwegID(id string) error {
+	parts := strings.Split(id, "-")
+	if len(parts) != 2 && len(parts) != 3 {
+		return fmt.Errorf("%w: %q must have 2 or 3 parts separated by dashes", ErrInvalidLeitwegID, id)
+	}
+
+	coarse, check := parts[0], parts[len(parts)-1]
+	if len(coarse) < 2 || len(coarse) > 12 || !isDigits(coarse) {
+		return fmt.Errorf("%w: %q coarse address must have 2 to 12 digits", ErrInvalidLeitwegID, id)
+	}
+
+	if len(check) != 2 || !isDigits(check) {
+		return fmt.Errorf("%w: %q must end with 2 check digits", ErrInvalidLeitwegID, id)
+	}
+
+	if len(parts) == 3 {
+		fine := parts[1]
+		if len(fine) == 0 || len(fine) > 30 || !isAlphanumeric(fine) {
+			return fmt.Errorf("%w: %q fine address must have 1 to 30 alphanumeric characters", ErrInvalidLeitwegID, id)
+		}
+	}
+
+	// ISO 7064 MOD 97-10, letters count as 10 to 35 like in an IBAN
+	rest := 0
+	for _, r := range strings.ToUpper(strings.Join(parts, "")) {
+		switch {
+		case r >= '0' && r <= '9':
+			rest = (rest*10 + int(r-'0')) % 97
+		default:
+			rest = (rest*100 + int(r-'A') + 10) % 97
+		}
+	}
+
+	if rest != 1 {
+		return fmt.Errorf("%w: %q has wrong check digits", ErrInvalidLeitwegID, id)
+	}
+
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
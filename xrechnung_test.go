@@ -0,0 +1,53 @@
+package golexoffice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lexoffice "github.com/karitham/go-lexoffice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateLeitwegID(t *testing.T) {
+	valid := []string{
+		"04011000-1234512345-06",
+		"991-33333TEST-33",
+		"991-33333test-33",
+	}
+	for _, id := range valid {
+		assert.NoError(t, lexoffice.ValidateLeitwegID(id), id)
+	}
+
+	invalid := []string{
+		"",
+		"04011000",
+		"04011000-1234512345-07",
+		"0-1234512345-06",
+		"0401100000000-1234512345-06",
+		"04011000-12345_12345-06",
+		"04011000-1234512345-6",
+		"0401100A-1234512345-06",
+		"04011000-1234512345123451234512345123451-06",
+	}
+	for _, id := range invalid {
+		assert.ErrorIs(t, lexoffice.ValidateLeitwegID(id), lexoffice.ErrInvalidLeitwegID, id)
+	}
+}
+
+func TestCreateInvoiceInvalidLeitwegID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL)
+	}))
+	defer server.Close()
+
+	config := lexoffice.NewClient("api-key", lexoffice.WithBaseUrl(server.URL))
+	_, err := config.CreateInvoice(context.Background(), lexoffice.CreateInvoiceOptions{
+		Finalize: true,
+		Body: lexoffice.InvoiceBody{
+			XRechnung: &lexoffice.XRechnung{BuyerReference: "04011000-1234512345-07"},
+		},
+	})
+	assert.ErrorIs(t, err, lexoffice.ErrInvalidLeitwegID)
+}